@@ -14,10 +14,24 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/internal/achievement"
+	"github.com/tnphucccc/mangahub/internal/activity"
 	"github.com/tnphucccc/mangahub/internal/auth"
+	"github.com/tnphucccc/mangahub/internal/club"
+	"github.com/tnphucccc/mangahub/internal/comment"
+	"github.com/tnphucccc/mangahub/internal/compatibility"
+	"github.com/tnphucccc/mangahub/internal/follow"
+	"github.com/tnphucccc/mangahub/internal/goal"
+	"github.com/tnphucccc/mangahub/internal/library"
 	"github.com/tnphucccc/mangahub/internal/manga"
 	"github.com/tnphucccc/mangahub/internal/middleware"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/queue"
+	"github.com/tnphucccc/mangahub/internal/review"
+	"github.com/tnphucccc/mangahub/internal/shelf"
 	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/tcp"
+	"github.com/tnphucccc/mangahub/internal/udp"
 	"github.com/tnphucccc/mangahub/internal/user"
 	"github.com/tnphucccc/mangahub/internal/websocket"
 	"github.com/tnphucccc/mangahub/pkg/config"
@@ -69,21 +83,71 @@ func main() {
 	userRepo := user.NewRepository(db)
 	mangaRepo := manga.NewRepository(db)
 	statsRepo := stats.NewRepository(db)
+	shelfRepo := shelf.NewRepository(db)
+	goalRepo := goal.NewRepository(db)
+	reviewRepo := review.NewRepository(db)
+	queueRepo := queue.NewRepository(db)
+	followRepo := follow.NewRepository(db)
+	activityRepo := activity.NewRepository(db)
+	commentRepo := comment.NewRepository(db)
+	clubRepo := club.NewRepository(db)
+	achievementRepo := achievement.NewRepository(db)
 
 	// Initialize services
 	userService := user.NewService(userRepo, jwtManager)
 	mangaService := manga.NewService(mangaRepo, userRepo)
 	statsService := stats.NewService(statsRepo)
+	shelfService := shelf.NewService(shelfRepo)
+	libraryService := library.NewService(mangaService)
+	goalService := goal.NewService(goalRepo, mangaService)
+	mangaService.OnProgressUpdate(goalService.CheckGoals)
+	reviewService := review.NewService(reviewRepo, mangaService)
+	queueService := queue.NewService(queueRepo, mangaService)
+	profileService := profile.NewService(userService, mangaService)
+	followService := follow.NewService(followRepo, userService, mangaService, profileService)
+	profileService.UseFollowerCheck(followService.IsFollowing)
+	activityService := activity.NewService(activityRepo)
+	mangaService.OnProgressChange(activityService.RecordProgressChange)
+	mangaService.OnProgressChange(statsService.RecordProgressChange)
+	reviewService.OnReviewCreated(activityService.RecordReview)
+	commentService := comment.NewService(commentRepo, mangaService)
+	compatibilityService := compatibility.NewService(userService, mangaService, profileService)
+	clubService := club.NewService(clubRepo, userService, mangaService, profileService)
+	achievementService := achievement.NewService(achievementRepo, userService)
+	mangaService.OnProgressUpdate(achievementService.CheckAchievements)
+	profileService.UseAchievementLookup(achievementService.Unlocked)
 
 	// Initialize handlers
 	userHandler := user.NewHandler(userService)
 	mangaHandler := manga.NewHandler(mangaService)
 	statsHandler := stats.NewHandler(statsService)
+	shelfHandler := shelf.NewHandler(shelfService)
+	libraryHandler := library.NewHandler(libraryService)
+	goalHandler := goal.NewHandler(goalService)
+	reviewHandler := review.NewHandler(reviewService)
+	queueHandler := queue.NewHandler(queueService)
+	profileHandler := profile.NewHandler(profileService)
+	followHandler := follow.NewHandler(followService)
+	activityHandler := activity.NewHandler(activityService)
+	commentHandler := comment.NewHandler(commentService)
+	compatibilityHandler := compatibility.NewHandler(compatibilityService)
+	clubHandler := club.NewHandler(clubService)
+	achievementHandler := achievement.NewHandler(achievementService)
 
 	// Initialize WebSocket hub and run it
 	wsHub := websocket.NewHub()
+	wsHub.UseAuthenticator(userService.ValidateToken)
 	go wsHub.Run(ctx) // Pass context to hub
 
+	// Announce unlocked achievements as chat system messages
+	achievementService.UseAnnouncer(wsHub)
+
+	// Open members-only chat rooms for reading clubs and send their weekly reminders
+	if err := clubService.UseChatRooms(wsHub); err != nil {
+		log.Fatalf("Failed to open club chat rooms: %v", err)
+	}
+	go clubService.RunReminders(ctx, time.Hour)
+
 	// Internal service addresses (for Docker networking)
 	tcpHost := utils.GetEnv("TCP_HOST", cfg.Server.Host)
 	udpHost := utils.GetEnv("UDP_HOST", cfg.Server.Host)
@@ -93,7 +157,7 @@ func main() {
 	udpAddr := net.JoinHostPort(udpHost, cfg.Server.UDPPort)
 
 	// Start UDP listener and bridge to WebSocket
-	go listenForUDPNotifications(ctx, wsHub, udpAddr)
+	go listenForUDPNotifications(ctx, wsHub, udpAddr, jwtManager)
 
 	// Initialize Gin router
 	router := gin.Default()
@@ -133,9 +197,26 @@ func main() {
 		// Public manga routes
 		mangaRoutes := api.Group("/manga")
 		{
-			mangaRoutes.GET("", mangaHandler.Search)      // Search manga
-			mangaRoutes.GET("/all", mangaHandler.GetAll)  // Get all manga
-			mangaRoutes.GET("/:id", mangaHandler.GetByID) // Get manga by ID
+			mangaRoutes.GET("", mangaHandler.Search)             // Search manga
+			mangaRoutes.GET("/all", mangaHandler.GetAll)         // Get all manga
+			mangaRoutes.GET("/changes", mangaHandler.GetChanges) // Catalog change feed
+			mangaRoutes.GET("/:id", mangaHandler.GetByID)        // Get manga by ID
+
+			// Reviews (a token, when sent, identifies the viewer)
+			mangaRoutes.GET("/:id/reviews", middleware.OptionalAuthMiddleware(userService), reviewHandler.ListForManga)
+
+			// Chapter comments
+			mangaRoutes.GET("/:id/chapters/:n/comments", commentHandler.List)            // List comment threads
+			mangaRoutes.GET("/:id/chapters/:n/comments/:comment_id", commentHandler.Get) // Get comment thread
+		}
+
+		// Protected chapter comment routes
+		commentRoutes := api.Group("/manga/:id/chapters/:n/comments")
+		commentRoutes.Use(middleware.AuthMiddleware(userService))
+		{
+			commentRoutes.POST("", commentHandler.Post)                 // Post comment or reply
+			commentRoutes.PUT("/:comment_id", commentHandler.Edit)      // Edit comment (author or admin)
+			commentRoutes.DELETE("/:comment_id", commentHandler.Delete) // Delete comment (author or admin)
 		}
 
 		// Protected user routes (require authentication)
@@ -143,11 +224,90 @@ func main() {
 		userRoutes.Use(middleware.AuthMiddleware(userService))
 		{
 			userRoutes.GET("/me", userHandler.GetProfile)                      // Get current user profile
+			userRoutes.PUT("/me/privacy", userHandler.UpdatePrivacy)           // Update privacy settings
 			userRoutes.GET("/library", mangaHandler.GetLibrary)                // Get user's library
 			userRoutes.POST("/library", mangaHandler.AddToLibrary)             // Add manga to library
+			userRoutes.POST("/library/import", libraryHandler.Import)          // Import library file
+			userRoutes.GET("/library/export", libraryHandler.Export)           // Export library file
 			userRoutes.GET("/progress/:manga_id", mangaHandler.GetProgress)    // Get progress for manga
 			userRoutes.PUT("/progress/:manga_id", mangaHandler.UpdateProgress) // Update reading progress
 			userRoutes.GET("/stats", statsHandler.GetStats)                    // Get user statistics
+			userRoutes.GET("/stats/timeline", statsHandler.GetTimeline)        // Chapters read over time and streaks
+			userRoutes.GET("/stats/composition", statsHandler.GetComposition)  // Library by genre, author, status, rating
+			userRoutes.GET("/achievements", achievementHandler.List)           // List achievements and progress
+
+			// Rereads
+			userRoutes.POST("/library/:manga_id/reread", mangaHandler.StartReread)   // Start a reread
+			userRoutes.GET("/library/:manga_id/reads", mangaHandler.GetReadThroughs) // List read-throughs
+
+			// Shelves and tags
+			userRoutes.GET("/shelves", shelfHandler.List)                                       // List shelves
+			userRoutes.POST("/shelves", shelfHandler.Create)                                    // Create shelf
+			userRoutes.PUT("/shelves/order", shelfHandler.Reorder)                              // Reorder shelves
+			userRoutes.GET("/shelves/:shelf_id", shelfHandler.Get)                              // Get shelf
+			userRoutes.PUT("/shelves/:shelf_id", shelfHandler.Update)                           // Update shelf
+			userRoutes.DELETE("/shelves/:shelf_id", shelfHandler.Delete)                        // Delete shelf
+			userRoutes.POST("/shelves/:shelf_id/entries", shelfHandler.AddEntry)                // Put manga on shelf
+			userRoutes.DELETE("/shelves/:shelf_id/entries/:manga_id", shelfHandler.RemoveEntry) // Take manga off shelf
+			userRoutes.GET("/tags", shelfHandler.ListTags)                                      // List tags
+			userRoutes.PUT("/library/:manga_id/tags", shelfHandler.SetTags)                     // Replace entry tags
+
+			// Favorites, priorities and up next
+			userRoutes.PATCH("/library/:manga_id", mangaHandler.UpdateEntry) // Star or prioritize entry
+			userRoutes.GET("/library/next", queueHandler.UpNext)             // Suggest what to read next
+			userRoutes.GET("/queue", queueHandler.List)                      // List reading queue
+			userRoutes.POST("/queue", queueHandler.Add)                      // Add entry to queue
+			userRoutes.PUT("/queue/order", queueHandler.Reorder)             // Reorder queue
+			userRoutes.DELETE("/queue/:manga_id", queueHandler.Remove)       // Remove entry from queue
+
+			// Reading goals
+			userRoutes.GET("/goals", goalHandler.List)               // List goals with progress
+			userRoutes.POST("/goals", goalHandler.Create)            // Create goal
+			userRoutes.GET("/goals/:goal_id", goalHandler.Get)       // Get goal with progress
+			userRoutes.PUT("/goals/:goal_id", goalHandler.Update)    // Update goal
+			userRoutes.DELETE("/goals/:goal_id", goalHandler.Delete) // Delete goal
+
+			// Reviews
+			userRoutes.GET("/reviews", reviewHandler.ListMine)                            // List own reviews
+			userRoutes.GET("/library/:manga_id/review", reviewHandler.Get)                // Get entry review
+			userRoutes.PUT("/library/:manga_id/review", reviewHandler.Write)              // Write or replace review
+			userRoutes.DELETE("/library/:manga_id/review", reviewHandler.Delete)          // Delete review
+			userRoutes.POST("/reviews/:review_id/helpful", reviewHandler.MarkHelpful)     // Vote review helpful
+			userRoutes.DELETE("/reviews/:review_id/helpful", reviewHandler.UnmarkHelpful) // Withdraw helpful vote
+
+			// Follows and activity feed
+			userRoutes.POST("/:username/follow", followHandler.Follow)                 // Follow user
+			userRoutes.DELETE("/:username/follow", followHandler.Unfollow)             // Unfollow user
+			userRoutes.DELETE("/me/followers/:username", followHandler.RemoveFollower) // Remove a follower
+			userRoutes.GET("/feed", activityHandler.Feed)                              // Activity of followed users
+
+			// Taste compatibility
+			userRoutes.GET("/:username/compatibility", compatibilityHandler.Compare) // Compare ratings and genres with a user
+		}
+
+		// Reading clubs (members only)
+		clubRoutes := api.Group("/clubs")
+		clubRoutes.Use(middleware.AuthMiddleware(userService))
+		{
+			clubRoutes.POST("", clubHandler.Create)                                    // Create club
+			clubRoutes.GET("", clubHandler.List)                                       // List own clubs
+			clubRoutes.GET("/:club_id", clubHandler.Get)                               // Get club and members
+			clubRoutes.PUT("/:club_id", clubHandler.Update)                            // Update club or schedule (owner)
+			clubRoutes.DELETE("/:club_id", clubHandler.Delete)                         // Delete club (owner)
+			clubRoutes.POST("/:club_id/members", clubHandler.AddMember)                // Add member (owner)
+			clubRoutes.DELETE("/:club_id/members/:username", clubHandler.RemoveMember) // Remove member or leave
+			clubRoutes.GET("/:club_id/progress", clubHandler.Progress)                 // Member progress vs schedule
+			clubRoutes.POST("/:club_id/remind", clubHandler.Remind)                    // Remind members now (owner)
+		}
+
+		// Public profiles (a token, when sent, identifies the viewer)
+		profileRoutes := api.Group("/users")
+		profileRoutes.Use(middleware.OptionalAuthMiddleware(userService))
+		{
+			profileRoutes.GET("/:username", profileHandler.GetProfile)         // Get public profile
+			profileRoutes.GET("/:username/library", profileHandler.GetLibrary) // Get public library
+			profileRoutes.GET("/:username/followers", followHandler.Followers) // List followers
+			profileRoutes.GET("/:username/following", followHandler.Following) // List followed users
 		}
 
 		// Admin routes (simplified for demo)
@@ -162,6 +322,7 @@ func main() {
 				mangaService.NotifyNotification(req)
 				c.JSON(200, gin.H{"message": "Notification queued"})
 			})
+			adminRoutes.GET("/manga/chapter-suggestions", mangaHandler.GetChapterSuggestions) // Suggested totals for ongoing manga
 		}
 	}
 
@@ -206,7 +367,7 @@ func main() {
 		for {
 			select {
 			case progress := <-mangaService.TCPBroadcastChan:
-				notifyTCPServer(tcpAddr, progress)
+				notifyTCPServer(tcpAddr, jwtManager, progress)
 			case notification := <-mangaService.UDPNotificationChan:
 				notifyUDPServer(udpAddr, notification)
 			case <-ctx.Done():
@@ -238,72 +399,27 @@ func main() {
 	log.Println("HTTP server will stop once main goroutine exits.")
 }
 
-// notifyTCPServer connects to the TCP server and sends a progress update broadcast request.
-func notifyTCPServer(address string, progress models.TCPProgressBroadcast) {
-	conn, err := net.DialTimeout("tcp", address, 2*time.Second)
-	if err != nil {
-		log.Printf("Failed to connect to TCP server for notification: %v", err)
+// notifyTCPServer forwards a stored progress update to the TCP server for broadcasting.
+func notifyTCPServer(address string, jwtManager *auth.JWTManager, progress models.TCPProgressBroadcast) {
+	if err := tcp.NotifyProgress(address, jwtManager, progress); err != nil {
+		log.Printf("Failed to notify TCP server about progress: %v", err)
 		return
 	}
-	defer conn.Close()
-
-	// The TCP server expects a JSON message followed by a newline.
-	// But it also expects authentication first. 
-	// To simplify for the academic project, we'll use a specific message type 
-	// or the TCP server's internal channel if they were in the same process.
-	// Since they are separate, we'll just send a TCPProgress message.
-	
-	msg := models.TCPMessage{
-		Type:      models.TCPMessageTypeProgress,
-		Timestamp: time.Now(),
-		Data: models.TCPProgressMessage{
-			MangaID:        progress.MangaID,
-			MangaTitle:     progress.MangaTitle,
-			Username:       progress.Username,
-			CurrentChapter: progress.CurrentChapter,
-			Status:         models.ReadingStatus(progress.Status),
-		},
-	}
-
-	data, _ := json.Marshal(msg)
-	data = append(data, '\n')
-	conn.Write(data)
-	log.Printf("Notified TCP server about progress: %s - Chapter %d", progress.MangaID, progress.CurrentChapter)
+	log.Printf("Notified TCP server about progress: %s - Chapter %s", progress.MangaID, progress.CurrentChapter)
 }
 
 // notifyUDPServer sends a notification message to the UDP server.
 func notifyUDPServer(address string, notification models.UDPNotification) {
-	addr, err := net.ResolveUDPAddr("udp", address)
-	if err != nil {
-		log.Printf("Failed to resolve UDP address: %v", err)
+	if err := udp.Notify(address, notification); err != nil {
+		log.Printf("Failed to notify UDP server: %v", err)
 		return
 	}
-
-	conn, err := net.DialUDP("udp", nil, addr)
-	if err != nil {
-		log.Printf("Failed to dial UDP: %v", err)
-		return
-	}
-	defer conn.Close()
-
-	// We'll just send a special notification message that the UDP server can broadcast.
-	// Note: The current UDP server only broadcasts what it receives via its internal 'notify' channel.
-	// We need to make sure the UDP server can receive this from "trusted" sources.
-	
-	msg := models.UDPMessage{
-		Type:      models.UDPMessageTypeNotification,
-		Timestamp: time.Now(),
-		Data:      notification,
-	}
-
-	data, _ := json.Marshal(msg)
-	conn.Write(data)
-	log.Printf("Notified UDP server about new chapter: %s - Chapter %d", notification.MangaTitle, notification.ChapterNumber)
+	log.Printf("Notified UDP server about new chapter: %s - Chapter %s", notification.MangaTitle, notification.ChapterNumber)
 }
 
 // listenForUDPNotifications acts as a UDP client, registers with the UDP server,
 // sends heartbeats, and forwards notifications to the WebSocket hub.
-func listenForUDPNotifications(ctx context.Context, hub *websocket.Hub, serverAddress string) {
+func listenForUDPNotifications(ctx context.Context, hub *websocket.Hub, serverAddress string, jwtManager *auth.JWTManager) {
 	clientID := uuid.New().String()
 	log.Printf("UDP client for API server generated ID: %s", clientID)
 
@@ -331,10 +447,10 @@ func listenForUDPNotifications(ctx context.Context, hub *websocket.Hub, serverAd
 	go receiveUDPMessages(ctx, conn, hub, clientID, serverUDPAddr, lastPong)
 
 	// Initial registration
-	registerMessage := models.UDPRegisterMessage{
-		ClientID: clientID,
-		UserID:   "api-server-user", // A placeholder user ID for the API server itself
-		Username: "api-server",
+	registerMessage, err := udpRegistration(jwtManager, clientID)
+	if err != nil {
+		log.Printf("Failed to create UDP registration: %v", err)
+		return
 	}
 	err = sendUDPMessage(conn, serverUDPAddr, models.UDPMessageTypeRegister, registerMessage)
 	if err != nil {
@@ -344,7 +460,7 @@ func listenForUDPNotifications(ctx context.Context, hub *websocket.Hub, serverAd
 	log.Printf("Sent UDP register message to %s from %s", serverAddress, conn.LocalAddr().String())
 
 	// Goroutine to send pings and manage re-registration
-	go startUDPPinger(ctx, conn, serverUDPAddr, clientID, lastPong)
+	go startUDPPinger(ctx, conn, serverUDPAddr, clientID, jwtManager, lastPong)
 
 	// Handle shutdown
 	<-ctx.Done()
@@ -417,12 +533,12 @@ func receiveUDPMessages(ctx context.Context, conn *net.UDPConn, hub *websocket.H
 					log.Printf("Error unmarshaling notification data: %v", err)
 					continue
 				}
-				log.Printf("Received UDP notification: %s - Ch %d", notification.MangaTitle, notification.ChapterNumber)
+				log.Printf("Received UDP notification: %s - Ch %s", notification.MangaTitle, notification.ChapterNumber)
 
 				wsMsg := models.WebSocketMessage{
 					Type:      models.WSSystemMessage,
 					Room:      "general",
-					Content:   fmt.Sprintf("New Chapter Release: %s - Chapter %d!", notification.MangaTitle, notification.ChapterNumber),
+					Content:   fmt.Sprintf("New Chapter Release: %s - Chapter %s!", notification.MangaTitle, notification.ChapterNumber),
 					Timestamp: time.Now(),
 				}
 				hub.Broadcast <- wsMsg
@@ -489,8 +605,18 @@ func receiveUDPMessages(ctx context.Context, conn *net.UDPConn, hub *websocket.H
 	}
 }
 
+// udpRegistration builds the API server's registration with the UDP server. It
+// registers with a service token, so it receives broadcasts but no user's notifications.
+func udpRegistration(jwtManager *auth.JWTManager, clientID string) (models.UDPRegisterMessage, error) {
+	token, err := jwtManager.GenerateServiceToken("api-server")
+	if err != nil {
+		return models.UDPRegisterMessage{}, err
+	}
+	return models.UDPRegisterMessage{ClientID: clientID, Token: token, Username: "api-server"}, nil
+}
+
 // startUDPPinger periodically sends ping messages and manages re-registration if pong is not received.
-func startUDPPinger(ctx context.Context, conn *net.UDPConn, serverUDPAddr *net.UDPAddr, clientID string, lastPong chan time.Time) {
+func startUDPPinger(ctx context.Context, conn *net.UDPConn, serverUDPAddr *net.UDPAddr, clientID string, jwtManager *auth.JWTManager, lastPong chan time.Time) {
 	pingInterval := 30 * time.Second
 	pongTimeout := 60 * time.Second // Time to wait for a pong before considering connection lost
 
@@ -510,12 +636,10 @@ func startUDPPinger(ctx context.Context, conn *net.UDPConn, serverUDPAddr *net.U
 			// Check if pong timeout occurred
 			if time.Since(lastPongReceivedTime) > pongTimeout {
 				log.Printf("No pong received for %v. Re-registering with UDP server...", pongTimeout)
-				registerMessage := models.UDPRegisterMessage{
-					ClientID: clientID,
-					UserID:   "api-server-user",
-					Username: "api-server",
+				registerMessage, err := udpRegistration(jwtManager, clientID)
+				if err == nil {
+					err = sendUDPMessage(conn, serverUDPAddr, models.UDPMessageTypeRegister, registerMessage)
 				}
-				err := sendUDPMessage(conn, serverUDPAddr, models.UDPMessageTypeRegister, registerMessage)
 				if err != nil {
 					log.Printf("Failed to re-send UDP register message: %v", err)
 				} else {
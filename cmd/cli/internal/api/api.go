@@ -0,0 +1,166 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// Error is returned when the API answers with an error response
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+	// Data holds the response data sent alongside the error, if any
+	Data json.RawMessage
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API returned status %d", e.StatusCode)
+	}
+	return e.Message
+}
+
+// envelope mirrors the API's standard response structure
+type envelope struct {
+	Success bool                    `json:"success"`
+	Data    json.RawMessage         `json:"data"`
+	Meta    climodels.Meta          `json:"meta"`
+	Error   climodels.ErrorResponse `json:"error"`
+}
+
+// MustLoadConfig loads the CLI config and exits if it is missing or,
+// when requireLogin is set, if the user is not logged in.
+func MustLoadConfig(requireLogin bool) *config.CLIConfig {
+	cliConfig, err := config.LoadCLIConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	if requireLogin && cliConfig.User.Token == "" {
+		fmt.Println("Error: Not logged in. Please use 'mangahub auth login' first.")
+		os.Exit(1)
+	}
+
+	return cliConfig
+}
+
+// BaseURL returns the HTTP API base URL for the configured server
+func BaseURL(cliConfig *config.CLIConfig) string {
+	return fmt.Sprintf("http://%s:%d/api/v1", cliConfig.Server.Host, cliConfig.Server.HTTPPort)
+}
+
+// NewRequest builds a request against the API, JSON-encoding body when given
+// and attaching the user's token when logged in.
+func NewRequest(cliConfig *config.CLIConfig, method, path string, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, BaseURL(cliConfig)+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if cliConfig.User.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+cliConfig.User.Token)
+	}
+
+	return req, nil
+}
+
+// Do sends a request to the API and decodes the response data into out (if not nil).
+// It returns the response metadata, or an *Error when the API reports a failure.
+func Do(cliConfig *config.CLIConfig, method, path string, body interface{}, out interface{}) (*climodels.Meta, error) {
+	req, err := NewRequest(cliConfig, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return Send(req, out)
+}
+
+// Send executes a prepared request and decodes the standard response envelope
+func Send(req *http.Request, out interface{}) (*climodels.Meta, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var apiResp envelope
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil && err != io.EOF {
+		if resp.StatusCode >= 400 {
+			return nil, &Error{StatusCode: resp.StatusCode}
+		}
+		return nil, fmt.Errorf("error decoding API response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 || !apiResp.Success {
+		return nil, &Error{
+			StatusCode: resp.StatusCode,
+			Code:       apiResp.Error.Code,
+			Message:    apiResp.Error.Message,
+			Data:       apiResp.Data,
+		}
+	}
+
+	if out != nil && len(apiResp.Data) > 0 {
+		if err := json.Unmarshal(apiResp.Data, out); err != nil {
+			return nil, fmt.Errorf("error decoding API response: %w", err)
+		}
+	}
+
+	return &apiResp.Meta, nil
+}
+
+// Download executes a request for a raw (non-enveloped) response and returns the
+// body with its Content-Disposition filename, decoding the envelope on errors.
+func Download(req *http.Request) ([]byte, string, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("error connecting to API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		var apiResp envelope
+		if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+			return nil, "", &Error{StatusCode: resp.StatusCode}
+		}
+		return nil, "", &Error{
+			StatusCode: resp.StatusCode,
+			Code:       apiResp.Error.Code,
+			Message:    apiResp.Error.Message,
+		}
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading API response: %w", err)
+	}
+
+	filename := ""
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		filename = params["filename"]
+	}
+
+	return data, filename, nil
+}
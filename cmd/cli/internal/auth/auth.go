@@ -114,6 +114,7 @@ func authRegister() {
 			os.Exit(1)
 		}
 
+		cliConfig.User.ID = apiResp.Data.User.ID
 		cliConfig.User.Username = apiResp.Data.User.Username
 		cliConfig.User.Token = apiResp.Data.Token
 		if err := config.SaveCLIConfig(cliConfig); err != nil {
@@ -190,6 +191,7 @@ func authLogin() {
 			os.Exit(1)
 		}
 
+		cliConfig.User.ID = apiResp.Data.User.ID
 		cliConfig.User.Username = apiResp.Data.User.Username
 		cliConfig.User.Token = apiResp.Data.Token
 		if err := config.SaveCLIConfig(cliConfig); err != nil {
@@ -227,6 +229,7 @@ func authLogout() {
 		return
 	}
 
+	cliConfig.User.ID = ""
 	cliConfig.User.Username = ""
 	cliConfig.User.Token = ""
 	if err := config.SaveCLIConfig(cliConfig); err != nil {
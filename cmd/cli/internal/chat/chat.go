@@ -41,6 +41,7 @@ func printChatUsage() {
 	fmt.Println("  join                 Join the real-time chat")
 	fmt.Println("\nFlags:")
 	fmt.Println("  --manga-id <id>      Join manga-specific chat room (default: general)")
+	fmt.Println("  --club <club_id>     Join a reading club's chat room (members only)")
 }
 
 func chatJoin() {
@@ -55,12 +56,14 @@ func chatJoin() {
 		os.Exit(1)
 	}
 
-	// Parse flags for manga-id
-	var mangaID string
+	// Parse flags for manga-id and club
+	var mangaID, clubID string
 	for i := 3; i < len(os.Args); i++ {
 		if os.Args[i] == "--manga-id" && i+1 < len(os.Args) {
 			mangaID = os.Args[i+1]
-			break
+		}
+		if os.Args[i] == "--club" && i+1 < len(os.Args) {
+			clubID = os.Args[i+1]
 		}
 	}
 
@@ -69,6 +72,9 @@ func chatJoin() {
 	if mangaID != "" {
 		room = mangaID
 	}
+	if clubID != "" {
+		room = models.ClubChatRoom(clubID)
+	}
 
 	// Build WebSocket URL with username and room as query parameters
 	u := url.URL{
@@ -78,10 +84,11 @@ func chatJoin() {
 	}
 	q := u.Query()
 	q.Set("username", cliConfig.User.Username)
+	q.Set("token", cliConfig.User.Token) // Identifies you; club rooms need it
 	q.Set("room", room)
 	u.RawQuery = q.Encode()
 
-	fmt.Printf("Connecting to WebSocket chat server at %s...\n", u.String())
+	fmt.Printf("Connecting to WebSocket chat server at %s://%s%s...\n", u.Scheme, u.Host, u.Path)
 
 	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
 	if err != nil {
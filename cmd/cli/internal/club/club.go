@@ -0,0 +1,372 @@
+package club
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func HandleClubCommand() {
+	if len(os.Args) < 3 {
+		printClubUsage()
+		os.Exit(1)
+	}
+
+	subcommand := os.Args[2]
+
+	switch subcommand {
+	case "create":
+		clubCreate()
+	case "list":
+		clubList()
+	case "show":
+		clubShow()
+	case "update":
+		clubUpdate()
+	case "delete":
+		clubDelete()
+	case "add":
+		clubAdd()
+	case "remove":
+		clubRemove()
+	case "leave":
+		clubLeave()
+	case "progress":
+		clubProgress()
+	case "remind":
+		clubRemind()
+	case "help":
+		printClubUsage()
+	default:
+		fmt.Printf("Unknown club subcommand: %s\n", subcommand)
+		printClubUsage()
+		os.Exit(1)
+	}
+}
+
+func printClubUsage() {
+	fmt.Println("Usage: mangahub club <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  create <name> [--manga=<id>] [--start=<chapter>] [--pace=<chapters per week>] [--description=<text>]")
+	fmt.Println("                                      Start a club; you become its owner")
+	fmt.Println("  list                                List your clubs")
+	fmt.Println("  show <club_id>                      Show a club and its members")
+	fmt.Println("  update <club_id> [--name=] [--description=] [--manga=] [--start=] [--pace=]")
+	fmt.Println("                                      Change a club (owner); a new manga, start or pace restarts the schedule")
+	fmt.Println("  delete <club_id>                    Delete a club (owner)")
+	fmt.Println("  add <club_id> <username>            Add a member (owner)")
+	fmt.Println("  remove <club_id> <username>         Remove a member (owner)")
+	fmt.Println("  leave <club_id>                     Leave a club")
+	fmt.Println("  progress <club_id>                  Compare members with the schedule")
+	fmt.Println("  remind <club_id>                    Remind members behind this week's target (owner)")
+	fmt.Println("\nChat with members: mangahub chat join --club <club_id>")
+	fmt.Println("Reminders arrive through: mangahub notify listen")
+}
+
+// clubPath returns the API path of a club
+func clubPath(clubID string) string {
+	return "/clubs/" + url.PathEscape(clubID)
+}
+
+// parseClubFlags reads --name, --description, --manga, --start and --pace options
+func parseClubFlags(args []string) climodels.ClubUpdateRequest {
+	var req climodels.ClubUpdateRequest
+	for _, arg := range args {
+		name, value, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--name":
+			req.Name = &value
+		case "--description":
+			req.Description = &value
+		case "--manga":
+			req.MangaID = &value
+		case "--start":
+			start, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				fmt.Printf("Invalid start chapter: %s\n", value)
+				os.Exit(1)
+			}
+			req.StartChapter = &start
+		case "--pace":
+			pace, err := strconv.Atoi(value)
+			if err != nil {
+				fmt.Printf("Invalid pace: %s\n", value)
+				os.Exit(1)
+			}
+			req.ChaptersPerWeek = &pace
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			os.Exit(1)
+		}
+	}
+	return req
+}
+
+func clubCreate() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club create <name> [--manga=<id>] [--start=<chapter>] [--pace=<chapters per week>] [--description=<text>]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var words, options []string
+	for _, arg := range os.Args[3:] {
+		if strings.HasPrefix(arg, "--") {
+			options = append(options, arg)
+		} else {
+			words = append(words, arg)
+		}
+	}
+	flags := parseClubFlags(options)
+	if flags.Name != nil {
+		fmt.Println("Give the club's name as the first argument, not with --name")
+		os.Exit(1)
+	}
+
+	reqBody := climodels.ClubCreateRequest{Name: strings.Join(words, " "), MangaID: flags.MangaID}
+	if flags.Description != nil {
+		reqBody.Description = *flags.Description
+	}
+	if flags.StartChapter != nil {
+		reqBody.StartChapter = *flags.StartChapter
+	}
+	if flags.ChaptersPerWeek != nil {
+		reqBody.ChaptersPerWeek = *flags.ChaptersPerWeek
+	}
+
+	var data climodels.ClubDetails
+	if _, err := api.Do(cliConfig, "POST", "/clubs", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to create club: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Club '%s' created (ID: %s)\n", data.Club.Name, data.Club.ID)
+	fmt.Printf("Add members with 'mangahub club add %s <username>'\n", data.Club.ID)
+}
+
+func clubList() {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ClubListResponse
+	if _, err := api.Do(cliConfig, "GET", "/clubs", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list clubs: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("You are not in any clubs yet. Start one with 'mangahub club create <name>'.")
+		return
+	}
+
+	fmt.Println("Your Clubs:")
+	for _, club := range data.Items {
+		fmt.Printf("  %s (%d members, owner %s)\n", club.Name, club.MemberCount, club.Owner)
+		fmt.Printf("    %s\n", describeSchedule(club))
+		fmt.Printf("    ID: %s\n", club.ID)
+	}
+}
+
+// describeSchedule phrases what a club is reading and at what pace
+func describeSchedule(club climodels.Club) string {
+	if club.MangaID == nil {
+		return "No manga scheduled"
+	}
+	title := club.MangaTitle
+	if title == "" {
+		title = *club.MangaID
+	}
+	return fmt.Sprintf("Reading %s from chapter %g, %d chapters a week since %s",
+		title, club.StartChapter, club.ChaptersPerWeek, club.ScheduleStart.Local().Format("2006-01-02"))
+}
+
+func clubShow() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club show <club_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ClubDetails
+	if _, err := api.Do(cliConfig, "GET", clubPath(os.Args[3]), nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get club: %v\n", err)
+		os.Exit(1)
+	}
+	printClub(data)
+}
+
+func printClub(data climodels.ClubDetails) {
+	club := data.Club
+	fmt.Printf("--- %s ---\n", club.Name)
+	if club.Description != "" {
+		fmt.Println(club.Description)
+	}
+	fmt.Println(describeSchedule(club))
+	fmt.Printf("Members (%d):\n", len(data.Members))
+	for _, member := range data.Members {
+		line := "  " + member.Username
+		if member.Owner {
+			line += " (owner)"
+		}
+		fmt.Println(line)
+	}
+	fmt.Printf("Chat: mangahub chat join --club %s\n", club.ID)
+}
+
+func clubUpdate() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub club update <club_id> [--name=] [--description=] [--manga=] [--start=] [--pace=]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	reqBody := parseClubFlags(os.Args[4:])
+
+	var data climodels.ClubDetails
+	if _, err := api.Do(cliConfig, "PUT", clubPath(os.Args[3]), reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to update club: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Club updated")
+	printClub(data)
+}
+
+func clubDelete() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club delete <club_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", clubPath(os.Args[3]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to delete club: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Club deleted")
+}
+
+func clubAdd() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub club add <club_id> <username>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ClubDetails
+	reqBody := climodels.ClubMemberRequest{Username: os.Args[4]}
+	if _, err := api.Do(cliConfig, "POST", clubPath(os.Args[3])+"/members", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to add member: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ %s added to %s (%d members)\n", os.Args[4], data.Club.Name, len(data.Members))
+}
+
+func clubRemove() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub club remove <club_id> <username>")
+		os.Exit(1)
+	}
+
+	removeMember(os.Args[3], os.Args[4])
+	fmt.Printf("✅ %s removed from the club\n", os.Args[4])
+}
+
+func clubLeave() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club leave <club_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+	if cliConfig.User.Username == "" {
+		fmt.Println("Error: username missing from config. Please log in again.")
+		os.Exit(1)
+	}
+
+	removeMember(os.Args[3], cliConfig.User.Username)
+	fmt.Println("✅ You left the club")
+}
+
+func removeMember(clubID, username string) {
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", clubPath(clubID)+"/members/"+url.PathEscape(username), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to remove member: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func clubProgress() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club progress <club_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ClubProgress
+	if _, err := api.Do(cliConfig, "GET", clubPath(os.Args[3])+"/progress", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get club progress: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("--- %s: %s, week %d ---\n", data.Club.Name, data.Club.MangaTitle, data.Week)
+	if data.DueChapter != nil && data.TargetChapter != nil {
+		fmt.Printf("Due by last week: chapter %g    This week's target: chapter %g\n", *data.DueChapter, *data.TargetChapter)
+	}
+	for _, member := range data.Members {
+		fmt.Printf("  %-20s %s\n", member.Username, describeMemberProgress(member))
+	}
+}
+
+// describeMemberProgress phrases where a member is against the schedule
+func describeMemberProgress(member climodels.ClubMemberProgress) string {
+	switch member.Status {
+	case "hidden":
+		return "progress hidden"
+	case "not_started":
+		return "not started"
+	}
+
+	text := fmt.Sprintf("chapter %g", *member.Chapter)
+	switch member.Status {
+	case "behind":
+		if member.ChaptersBehind != nil {
+			return fmt.Sprintf("%s - behind by %g", text, *member.ChaptersBehind)
+		}
+		return text + " - behind"
+	case "ahead":
+		return text + " - done for the week"
+	default:
+		return text + " - on track"
+	}
+}
+
+func clubRemind() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub club remind <club_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ClubRemindResponse
+	if _, err := api.Do(cliConfig, "POST", clubPath(os.Args[3])+"/remind", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to send reminders: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Reminded %d member(s)\n", data.Reminded)
+}
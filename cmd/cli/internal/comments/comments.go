@@ -0,0 +1,252 @@
+package comments
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func HandleCommentsCommand() {
+	if len(os.Args) < 3 {
+		printCommentsUsage()
+		os.Exit(1)
+	}
+
+	subcommand := os.Args[2]
+
+	switch subcommand {
+	case "list":
+		commentsList()
+	case "show":
+		commentsShow()
+	case "post":
+		commentsPost()
+	case "reply":
+		commentsReply()
+	case "edit":
+		commentsEdit()
+	case "delete":
+		commentsDelete()
+	case "help":
+		printCommentsUsage()
+	default:
+		fmt.Printf("Unknown comments subcommand: %s\n", subcommand)
+		printCommentsUsage()
+		os.Exit(1)
+	}
+}
+
+func printCommentsUsage() {
+	fmt.Println("Usage: mangahub comments <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  list <manga_id> <chapter> [--sort=newest|oldest] [--spoilers] [--limit=N] [--offset=N]")
+	fmt.Println("                                                       List a chapter's comment threads (spoilers hidden by default)")
+	fmt.Println("  show <manga_id> <chapter> <comment_id> [--spoilers]  Show a comment with its replies")
+	fmt.Println("  post <manga_id> <chapter> <text> [--spoiler]         Comment on a chapter")
+	fmt.Println("  reply <manga_id> <chapter> <comment_id> <text> [--spoiler]")
+	fmt.Println("                                                       Reply to a comment")
+	fmt.Println("  edit <manga_id> <chapter> <comment_id> <text> [--spoiler|--no-spoiler]")
+	fmt.Println("                                                       Edit your comment")
+	fmt.Println("  delete <manga_id> <chapter> <comment_id>             Delete your comment")
+}
+
+// commentsPath returns the API path of a chapter's comments
+func commentsPath(mangaID, chapter string) string {
+	return "/manga/" + url.PathEscape(mangaID) + "/chapters/" + url.PathEscape(chapter) + "/comments"
+}
+
+func commentsList() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub comments list <manga_id> <chapter> [--sort=newest|oldest] [--spoilers] [--limit=N] [--offset=N]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(false)
+
+	mangaID, chapter := os.Args[3], os.Args[4]
+	params := url.Values{}
+	showSpoilers := false
+	for _, arg := range os.Args[5:] {
+		name, value, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--sort":
+			params.Set("sort", value)
+		case "--limit":
+			params.Set("limit", value)
+		case "--offset":
+			params.Set("offset", value)
+		case "--spoilers":
+			showSpoilers = true
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			os.Exit(1)
+		}
+	}
+
+	path := commentsPath(mangaID, chapter)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var data climodels.CommentListResponse
+	meta, err := api.Do(cliConfig, "GET", path, nil, &data)
+	if err != nil {
+		fmt.Printf("❌ Failed to list comments: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("No comments on this chapter yet.")
+		return
+	}
+
+	fmt.Printf("Comments on chapter %s (%d threads):\n", chapter, meta.Total)
+	for _, comment := range data.Items {
+		fmt.Println()
+		printComment(comment, 1, showSpoilers)
+	}
+	if meta.HasMore {
+		fmt.Printf("\nMore with --offset=%d\n", meta.Offset+meta.Limit)
+	}
+}
+
+func commentsShow() {
+	if len(os.Args) < 6 {
+		fmt.Println("Usage: mangahub comments show <manga_id> <chapter> <comment_id> [--spoilers]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(false)
+
+	showSpoilers := len(os.Args) > 6 && os.Args[6] == "--spoilers"
+
+	var data climodels.CommentResponse
+	if _, err := api.Do(cliConfig, "GET", commentsPath(os.Args[3], os.Args[4])+"/"+url.PathEscape(os.Args[5]), nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get comment: %v\n", err)
+		os.Exit(1)
+	}
+
+	printComment(data.Comment, 1, showSpoilers)
+}
+
+// printComment prints a comment and its replies, indenting each reply level
+func printComment(comment climodels.ChapterComment, depth int, showSpoilers bool) {
+	indent := strings.Repeat("  ", depth)
+
+	if comment.Deleted {
+		fmt.Printf("%s[deleted] (%s)\n", indent, comment.ID)
+	} else {
+		line := fmt.Sprintf("%s%s, %s", indent, comment.Username, comment.CreatedAt.Local().Format("2006-01-02 15:04"))
+		if comment.EditedAt != nil {
+			line += " (edited)"
+		}
+		fmt.Printf("%s - ID: %s\n", line, comment.ID)
+
+		if comment.Spoiler && !showSpoilers {
+			fmt.Printf("%s  [Spoiler hidden - use --spoilers to show]\n", indent)
+		} else {
+			if comment.Spoiler {
+				fmt.Printf("%s  [Spoiler]\n", indent)
+			}
+			for _, paragraph := range strings.Split(comment.Body, "\n") {
+				fmt.Printf("%s  %s\n", indent, paragraph)
+			}
+		}
+	}
+
+	for _, reply := range comment.Replies {
+		printComment(reply, depth+1, showSpoilers)
+	}
+}
+
+// commentText joins the words of a comment and reports its spoiler flag
+func commentText(args []string) (string, *bool) {
+	var words []string
+	var spoiler *bool
+	for _, arg := range args {
+		switch arg {
+		case "--spoiler":
+			value := true
+			spoiler = &value
+		case "--no-spoiler":
+			value := false
+			spoiler = &value
+		default:
+			words = append(words, arg)
+		}
+	}
+	return strings.Join(words, " "), spoiler
+}
+
+func commentsPost() {
+	if len(os.Args) < 6 {
+		fmt.Println("Usage: mangahub comments post <manga_id> <chapter> <text> [--spoiler]")
+		os.Exit(1)
+	}
+
+	body, spoiler := commentText(os.Args[5:])
+	postComment(os.Args[3], os.Args[4], climodels.CommentRequest{Body: body, Spoiler: spoiler != nil && *spoiler})
+}
+
+func commentsReply() {
+	if len(os.Args) < 7 {
+		fmt.Println("Usage: mangahub comments reply <manga_id> <chapter> <comment_id> <text> [--spoiler]")
+		os.Exit(1)
+	}
+
+	parentID := os.Args[5]
+	body, spoiler := commentText(os.Args[6:])
+	postComment(os.Args[3], os.Args[4], climodels.CommentRequest{Body: body, Spoiler: spoiler != nil && *spoiler, ParentID: &parentID})
+}
+
+func postComment(mangaID, chapter string, reqBody climodels.CommentRequest) {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.CommentResponse
+	if _, err := api.Do(cliConfig, "POST", commentsPath(mangaID, chapter), reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to post comment: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Comment posted (ID: %s)\n", data.Comment.ID)
+}
+
+func commentsEdit() {
+	if len(os.Args) < 7 {
+		fmt.Println("Usage: mangahub comments edit <manga_id> <chapter> <comment_id> <text> [--spoiler|--no-spoiler]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	body, spoiler := commentText(os.Args[6:])
+	reqBody := climodels.CommentUpdateRequest{Body: body, Spoiler: spoiler}
+
+	var data climodels.CommentResponse
+	if _, err := api.Do(cliConfig, "PUT", commentsPath(os.Args[3], os.Args[4])+"/"+url.PathEscape(os.Args[5]), reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to edit comment: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Comment %s updated\n", data.Comment.ID)
+}
+
+func commentsDelete() {
+	if len(os.Args) < 6 {
+		fmt.Println("Usage: mangahub comments delete <manga_id> <chapter> <comment_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", commentsPath(os.Args[3], os.Args[4])+"/"+url.PathEscape(os.Args[5]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to delete comment: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Comment deleted")
+}
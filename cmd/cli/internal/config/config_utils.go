@@ -36,6 +36,7 @@ type DatabaseConfig struct {
 
 // UserConfig holds user-specific configuration (e.g., current authenticated user token)
 type UserConfig struct {
+	ID       string `yaml:"id"`
 	Username string `yaml:"username"`
 	Token    string `yaml:"token"`
 }
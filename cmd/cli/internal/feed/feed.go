@@ -0,0 +1,85 @@
+package feed
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func HandleFeedCommand() {
+	if len(os.Args) > 2 && os.Args[2] == "help" {
+		printFeedUsage()
+		return
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	params := url.Values{}
+	for _, arg := range os.Args[2:] {
+		switch {
+		case strings.HasPrefix(arg, "--limit="):
+			params.Set("limit", strings.TrimPrefix(arg, "--limit="))
+		case strings.HasPrefix(arg, "--offset="):
+			params.Set("offset", strings.TrimPrefix(arg, "--offset="))
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			printFeedUsage()
+			os.Exit(1)
+		}
+	}
+
+	var data climodels.FeedResponse
+	meta, err := api.Do(cliConfig, "GET", "/users/feed?"+params.Encode(), nil, &data)
+	if err != nil {
+		fmt.Printf("❌ Failed to get activity feed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("Nothing in your feed yet. Follow other readers with 'mangahub user follow <username>'.")
+		return
+	}
+
+	if meta.TotalPages > 1 {
+		fmt.Printf("Activity Feed (page %d of %d):\n", meta.Page, meta.TotalPages)
+	} else {
+		fmt.Println("Activity Feed:")
+	}
+	for _, event := range data.Items {
+		fmt.Printf("  %s  %s %s\n", event.CreatedAt.Local().Format("2006-01-02 15:04"), event.Username, describe(event))
+	}
+	if meta.HasMore {
+		fmt.Printf("\nMore with --offset=%d\n", meta.Offset+meta.Limit)
+	}
+}
+
+func printFeedUsage() {
+	fmt.Println("Usage: mangahub feed [--limit=N] [--offset=N]")
+	fmt.Println("\nShows recent reading activity of the users you follow, newest first.")
+}
+
+// describe phrases an activity event for the feed
+func describe(event climodels.ActivityEvent) string {
+	switch event.Type {
+	case "progress":
+		if event.Chapter != nil {
+			return fmt.Sprintf("read chapter %g of %s", *event.Chapter, event.MangaTitle)
+		}
+		return "read more of " + event.MangaTitle
+	case "completed":
+		return "finished " + event.MangaTitle
+	case "rated":
+		if event.Rating != nil {
+			return fmt.Sprintf("rated %s %d/10", event.MangaTitle, *event.Rating)
+		}
+		return "rated " + event.MangaTitle
+	case "reviewed":
+		return fmt.Sprintf("reviewed %s (see 'mangahub review list %s')", event.MangaTitle, event.MangaID)
+	default:
+		return fmt.Sprintf("%s %s", event.Type, event.MangaTitle)
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/stats"
 	"github.com/tnphucccc/mangahub/internal/grpc/pb"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 func HandleGRPCCommand() {
@@ -23,6 +26,8 @@ func HandleGRPCCommand() {
 	switch subcommand {
 	case "get":
 		grpcGet()
+	case "stats":
+		grpcStats()
 	default:
 		fmt.Printf("Unknown grpc subcommand: %s\n", subcommand)
 		printGRPCUsage()
@@ -34,6 +39,7 @@ func printGRPCUsage() {
 	fmt.Println("Usage: mangahub grpc <subcommand> [options]")
 	fmt.Println("\nSubcommands:")
 	fmt.Println("  get <id>             Get manga details via gRPC")
+	fmt.Println("  stats                Get your library composition via gRPC")
 }
 
 func grpcGet() {
@@ -75,4 +81,75 @@ func grpcGet() {
 	fmt.Printf("  Author: %s\n", resp.Author)
 	fmt.Printf("  Status: %s\n", resp.Status)
 	fmt.Printf("  Total Chapters: %d\n", resp.TotalChapters)
+	if r := resp.CommunityRating; r != nil {
+		fmt.Printf("  Community Score: %.2f (%d ratings, mean %.2f)\n", r.Score, r.Count, r.Mean)
+	} else {
+		fmt.Printf("  Community Score: not rated\n")
+	}
+}
+
+func grpcStats() {
+	cliConfig, err := config.LoadCLIConfig()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	if cliConfig.User.ID == "" || cliConfig.User.Token == "" {
+		fmt.Println("Error: Not logged in. Please use 'mangahub auth login' first.")
+		os.Exit(1)
+	}
+
+	addr := fmt.Sprintf("%s:%d", cliConfig.Server.Host, cliConfig.Server.GRPCPort)
+	fmt.Printf("Connecting to gRPC Server at %s...\n", addr)
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	client := pb.NewMangaServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The server checks who is asking against the library owner's privacy settings
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+cliConfig.User.Token)
+
+	resp, err := client.GetLibraryStats(ctx, &pb.LibraryStatsRequest{UserId: cliConfig.User.ID})
+	if err != nil {
+		fmt.Printf("❌ gRPC Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Received Library Composition via gRPC (%d titles):\n", resp.Titles)
+	fmt.Println()
+	stats.PrintCompositionTable("Genre", fromPBGroups(resp.Genres), 10)
+	fmt.Println()
+	stats.PrintCompositionTable("Publication", fromPBGroups(resp.PublicationStatuses), 0)
+	fmt.Println()
+	counts := make([]int, len(resp.RatingHistogram))
+	for i, n := range resp.RatingHistogram {
+		counts[i] = int(n)
+	}
+	stats.PrintRatingHistogram(counts, int(resp.Rated), resp.AverageRating)
+}
+
+func fromPBGroups(groups []*pb.CompositionGroup) []climodels.CompositionGroup {
+	result := make([]climodels.CompositionGroup, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, climodels.CompositionGroup{
+			Name:           g.Name,
+			Titles:         int(g.Titles),
+			Started:        int(g.Started),
+			Completed:      int(g.Completed),
+			Dropped:        int(g.Dropped),
+			ChaptersRead:   int(g.ChaptersRead),
+			Rated:          int(g.Rated),
+			AverageRating:  g.AverageRating,
+			CompletionRate: g.CompletionRate,
+			DroppedRate:    g.DroppedRate,
+		})
+	}
+	return result
 }
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
 	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
 )
 
@@ -26,6 +28,26 @@ func HandleLibraryCommand() {
 		libraryAdd()
 	case "list":
 		libraryList()
+	case "shelf":
+		handleShelfCommand()
+	case "tag":
+		libraryTag()
+	case "tags":
+		libraryTags()
+	case "next":
+		libraryNext()
+	case "favorite":
+		libraryFavorite()
+	case "priority":
+		libraryPriority()
+	case "hide":
+		libraryHide()
+	case "queue":
+		handleQueueCommand()
+	case "import":
+		libraryImport()
+	case "export":
+		libraryExport()
 	default:
 		fmt.Printf("Unknown library subcommand: %s\n", subcommand)
 		printLibraryUsage()
@@ -37,7 +59,21 @@ func printLibraryUsage() {
 	fmt.Println("Usage: mangahub library <subcommand> [options]")
 	fmt.Println("\nSubcommands:")
 	fmt.Println("  add <manga_id> --status=<status> [--chapter=<chapter>]")
-	fmt.Println("  list                                                     List user's library")
+	fmt.Println("  list [filters] [--sort=<key>] [--limit=<n>] [--offset=<n>] List user's library")
+	fmt.Println("  shelf <subcommand>                                       Manage shelves (list, create, add, ...)")
+	fmt.Println("  tag <manga_id> [<tag>,<tag>...]                          Replace the tags on an entry")
+	fmt.Println("  tags                                                     List your tags")
+	fmt.Println("  next [--limit=<n>]                                       Show what to read next")
+	fmt.Println("  favorite <manga_id> [--remove]                           Star (or unstar) an entry")
+	fmt.Println("  priority <manga_id> <none|low|medium|high>               Set an entry's reading priority")
+	fmt.Println("  hide <manga_id> [--unhide]                               Hide (or show) an entry on your public profile")
+	fmt.Println("  queue <subcommand>                                       Manage your reading queue (list, add, ...)")
+	fmt.Println("  import <file> [--format=mal] [--overwrite] [--dry-run]   Import a MyAnimeList export")
+	fmt.Println("  export [--format=mal|csv|json] [--output=<file>]         Export your library to a file")
+	fmt.Println("\nList filters:")
+	fmt.Println("  --status=<status>  --genre=<genre>  --search=<title>  --shelf=<shelf>  --tag=<tag>")
+	fmt.Println("  --min-rating=<1-10>  --max-rating=<1-10>  --unread  --caught-up  --favorites")
+	fmt.Println("  --sort=updated|title|rating|progress|priority  --order=asc|desc")
 }
 
 func libraryAdd() {
@@ -59,14 +95,14 @@ func libraryAdd() {
 
 	mangaID := os.Args[3]
 	status := ""
-	chapter := 0
+	chapter := 0.0
 
 	for i := 4; i < len(os.Args); i++ {
 		arg := os.Args[i]
 		if strings.HasPrefix(arg, "--status=") {
 			status = strings.TrimPrefix(arg, "--status=")
 		} else if strings.HasPrefix(arg, "--chapter=") {
-			chapter, err = strconv.Atoi(strings.TrimPrefix(arg, "--chapter="))
+			chapter, err = strconv.ParseFloat(strings.TrimPrefix(arg, "--chapter="), 64)
 			if err != nil {
 				fmt.Printf("Error: Invalid chapter number: %v\n", err)
 				os.Exit(1)
@@ -98,8 +134,17 @@ func libraryAdd() {
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		fmt.Printf("Error connecting to API: %v\n", err)
-		os.Exit(1)
+		if !offline.IsOffline(err) {
+			fmt.Printf("Error connecting to API: %v\n", err)
+			os.Exit(1)
+		}
+		if err := offline.Enqueue(cliConfig, offline.KindLibraryAdd, mangaID, reqBody, 0); err != nil {
+			fmt.Printf("Error: server unreachable and the addition could not be queued: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("📥 Server unreachable; adding '%s' to your library was queued.\n", mangaID)
+		fmt.Println("   It will be sent on the next successful connection (see 'mangahub sync status').")
+		return
 	}
 	defer resp.Body.Close()
 
@@ -136,7 +181,42 @@ func libraryList() {
 		os.Exit(1)
 	}
 
-	apiURL := fmt.Sprintf("http://%s:%d/api/v1/users/library", cliConfig.Server.Host, cliConfig.Server.HTTPPort)
+	// Map CLI flags to library query parameters
+	listFlags := map[string]string{
+		"--shelf=":      "shelf",
+		"--tag=":        "tag",
+		"--status=":     "status",
+		"--genre=":      "genre",
+		"--search=":     "q",
+		"--min-rating=": "min_rating",
+		"--max-rating=": "max_rating",
+		"--sort=":       "sort",
+		"--order=":      "order",
+		"--limit=":      "limit",
+		"--offset=":     "offset",
+	}
+
+	queryParams := url.Values{}
+	for _, arg := range os.Args[3:] {
+		switch arg {
+		case "--unread":
+			queryParams.Set("has_unread", "true")
+			continue
+		case "--caught-up":
+			queryParams.Set("has_unread", "false")
+			continue
+		case "--favorites":
+			queryParams.Set("favorite", "true")
+			continue
+		}
+		for flag, param := range listFlags {
+			if strings.HasPrefix(arg, flag) {
+				queryParams.Set(param, strings.TrimPrefix(arg, flag))
+			}
+		}
+	}
+
+	apiURL := fmt.Sprintf("http://%s:%d/api/v1/users/library?%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, queryParams.Encode())
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
 		fmt.Printf("Error creating request: %v\n", err)
@@ -170,21 +250,48 @@ func libraryList() {
 		}
 
 		if len(apiResp.Data.Items) == 0 {
-			fmt.Println("Your library is empty.")
+			if len(queryParams) > 0 {
+				fmt.Println("No library entries match your filters.")
+			} else {
+				fmt.Println("Your library is empty.")
+			}
 			return
 		}
 
-		fmt.Println("Your Manga Library:")
+		if apiResp.Meta.TotalPages > 0 {
+			fmt.Printf("Your Manga Library (%d entries, page %d of %d):\n", apiResp.Meta.Total, apiResp.Meta.Page, apiResp.Meta.TotalPages)
+		} else {
+			fmt.Printf("Your Manga Library (%d entries):\n", apiResp.Meta.Total)
+		}
 		for _, item := range apiResp.Data.Items {
-			fmt.Printf("  Title: %s\n", item.Manga.Title)
+			fmt.Printf("  Title: %s%s\n", item.Manga.Title, entryMarks(item.UserProgress))
 			fmt.Printf("  Status: %s\n", item.UserProgress.Status)
-			fmt.Printf("  Current Chapter: %d\n", item.UserProgress.CurrentChapter)
+			if item.Manga.TotalChapters > 0 {
+				fmt.Printf("  Current Chapter: %g/%d\n", item.UserProgress.CurrentChapter, item.Manga.TotalChapters)
+				if item.ProgressPercent != nil {
+					fmt.Printf("  Progress: %g%%\n", *item.ProgressPercent)
+				}
+			} else {
+				fmt.Printf("  Current Chapter: %g (total unknown)\n", item.UserProgress.CurrentChapter)
+			}
 			if item.UserProgress.Rating != nil && *item.UserProgress.Rating > 0 {
 				fmt.Printf("  Rating: %d/10\n", *item.UserProgress.Rating)
 			}
+			if item.UserProgress.RereadCount > 0 {
+				fmt.Printf("  Rereads: %d\n", item.UserProgress.RereadCount)
+			}
+			if len(item.Shelves) > 0 {
+				fmt.Printf("  Shelves: %s\n", strings.Join(item.Shelves, ", "))
+			}
+			if len(item.Tags) > 0 {
+				fmt.Printf("  Tags: %s\n", strings.Join(item.Tags, ", "))
+			}
 			fmt.Printf("  Updated At: %s\n", item.UserProgress.UpdatedAt)
 			fmt.Printf("  --------------------\n")
 		}
+		if apiResp.Meta.HasMore {
+			fmt.Printf("More entries available; use --offset=%d to see the next page.\n", apiResp.Meta.Offset+len(apiResp.Data.Items))
+		}
 	} else {
 		var apiResp struct {
 			Success bool                    `json:"success"`
@@ -0,0 +1,274 @@
+package library
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// priorityNames maps priority levels to the names accepted and shown by the CLI
+var priorityNames = []string{"none", "low", "medium", "high"}
+
+func libraryNext() {
+	cliConfig := api.MustLoadConfig(true)
+
+	params := url.Values{}
+	for _, arg := range os.Args[3:] {
+		if strings.HasPrefix(arg, "--limit=") {
+			params.Set("limit", strings.TrimPrefix(arg, "--limit="))
+		}
+	}
+
+	var data climodels.UpNextResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/library/next?"+params.Encode(), nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get up next: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("Nothing up next. Add manga you are reading or plan to read to your library.")
+		return
+	}
+
+	fmt.Println("Up Next:")
+	for _, item := range data.Items {
+		queued := ""
+		if item.Queued {
+			queued = " (queued)"
+		}
+		fmt.Printf("  %d. %s%s%s\n", item.Rank, item.Manga.Title, queued, entryMarks(item.UserProgress))
+		switch {
+		case item.UnreadChapters == nil:
+			fmt.Printf("     Chapter %g, %s (total unknown)\n", item.CurrentChapter, item.Status)
+		default:
+			fmt.Printf("     Chapter %g/%d, %s, %g unread\n", item.CurrentChapter, item.Manga.TotalChapters, item.Status, *item.UnreadChapters)
+		}
+		fmt.Printf("     ID: %s\n", item.MangaID)
+	}
+}
+
+// entryMarks describes the favorite, priority and hidden markers of an entry
+func entryMarks(progress climodels.UserProgress) string {
+	var marks []string
+	if progress.Favorite {
+		marks = append(marks, "★")
+	}
+	if progress.Priority > 0 && progress.Priority < len(priorityNames) {
+		marks = append(marks, priorityNames[progress.Priority]+" priority")
+	}
+	if progress.Hidden {
+		marks = append(marks, "hidden")
+	}
+	if len(marks) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(marks, ", ") + "]"
+}
+
+func libraryFavorite() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub library favorite <manga_id> [--remove]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	mangaID := os.Args[3]
+	favorite := !(len(os.Args) > 4 && os.Args[4] == "--remove")
+
+	reqBody := climodels.LibraryEntryUpdateRequest{Favorite: &favorite}
+	if _, err := api.Do(cliConfig, "PATCH", "/users/library/"+url.PathEscape(mangaID), reqBody, nil); err != nil {
+		fmt.Printf("❌ Failed to update favorite: %v\n", err)
+		os.Exit(1)
+	}
+
+	if favorite {
+		fmt.Printf("✅ '%s' added to your favorites\n", mangaID)
+	} else {
+		fmt.Printf("✅ '%s' removed from your favorites\n", mangaID)
+	}
+}
+
+func libraryPriority() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library priority <manga_id> <none|low|medium|high>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	mangaID := os.Args[3]
+	priority := -1
+	for level, name := range priorityNames {
+		if strings.EqualFold(os.Args[4], name) {
+			priority = level
+		}
+	}
+	if priority < 0 {
+		level, err := strconv.Atoi(os.Args[4])
+		if err != nil || level < 0 || level >= len(priorityNames) {
+			fmt.Println("Error: priority must be none, low, medium or high (or 0-3)")
+			os.Exit(1)
+		}
+		priority = level
+	}
+
+	reqBody := climodels.LibraryEntryUpdateRequest{Priority: &priority}
+	if _, err := api.Do(cliConfig, "PATCH", "/users/library/"+url.PathEscape(mangaID), reqBody, nil); err != nil {
+		fmt.Printf("❌ Failed to update priority: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Priority of '%s' set to %s\n", mangaID, priorityNames[priority])
+}
+
+func libraryHide() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub library hide <manga_id> [--unhide]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	mangaID := os.Args[3]
+	hidden := !(len(os.Args) > 4 && os.Args[4] == "--unhide")
+
+	reqBody := climodels.LibraryEntryUpdateRequest{Hidden: &hidden}
+	if _, err := api.Do(cliConfig, "PATCH", "/users/library/"+url.PathEscape(mangaID), reqBody, nil); err != nil {
+		fmt.Printf("❌ Failed to update entry: %v\n", err)
+		os.Exit(1)
+	}
+
+	if hidden {
+		fmt.Printf("✅ '%s' is now hidden from other users\n", mangaID)
+	} else {
+		fmt.Printf("✅ '%s' is visible to other users again\n", mangaID)
+	}
+}
+
+func handleQueueCommand() {
+	subcommand := "list"
+	if len(os.Args) > 3 {
+		subcommand = os.Args[3]
+	}
+
+	switch subcommand {
+	case "list":
+		queueList()
+	case "add":
+		queueAdd()
+	case "remove":
+		queueRemove()
+	case "order":
+		queueOrder()
+	default:
+		fmt.Printf("Unknown queue subcommand: %s\n", subcommand)
+		printQueueUsage()
+		os.Exit(1)
+	}
+}
+
+func printQueueUsage() {
+	fmt.Println("Usage: mangahub library queue <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  list                                   List your reading queue (default)")
+	fmt.Println("  add <manga_id> [--position=<n>]        Queue a library entry (at the end by default)")
+	fmt.Println("  remove <manga_id>                      Take an entry out of the queue")
+	fmt.Println("  order <manga_id> [<manga_id> ...]      Set the order of the whole queue")
+}
+
+func printQueue(items []climodels.QueueEntry) {
+	if len(items) == 0 {
+		fmt.Println("Your reading queue is empty. Add to it with 'mangahub library queue add <manga_id>'.")
+		return
+	}
+
+	fmt.Println("Reading Queue:")
+	for _, item := range items {
+		fmt.Printf("  %d. %s (%s)%s\n", item.Position+1, item.Manga.Title, item.MangaID, entryMarks(item.UserProgress))
+	}
+}
+
+func queueList() {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.QueueResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/queue", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list reading queue: %v\n", err)
+		os.Exit(1)
+	}
+
+	printQueue(data.Items)
+}
+
+func queueAdd() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library queue add <manga_id> [--position=<n>]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	reqBody := climodels.QueueAddRequest{MangaID: os.Args[4]}
+	for _, arg := range os.Args[5:] {
+		if strings.HasPrefix(arg, "--position=") {
+			position, err := strconv.Atoi(strings.TrimPrefix(arg, "--position="))
+			if err != nil || position < 1 {
+				fmt.Println("Error: --position must be a number starting at 1")
+				os.Exit(1)
+			}
+			position--
+			reqBody.Position = &position
+		}
+	}
+
+	var data climodels.QueueResponse
+	if _, err := api.Do(cliConfig, "POST", "/users/queue", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to add to reading queue: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ '%s' added to your reading queue\n", reqBody.MangaID)
+	printQueue(data.Items)
+}
+
+func queueRemove() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library queue remove <manga_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", "/users/queue/"+url.PathEscape(os.Args[4]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to remove from reading queue: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ '%s' removed from your reading queue\n", os.Args[4])
+}
+
+func queueOrder() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library queue order <manga_id> [<manga_id> ...]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	reqBody := climodels.QueueOrderRequest{MangaIDs: os.Args[4:]}
+
+	var data climodels.QueueResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/queue/order", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to reorder reading queue: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Reading queue reordered:")
+	printQueue(data.Items)
+}
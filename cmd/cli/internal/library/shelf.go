@@ -0,0 +1,268 @@
+package library
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func handleShelfCommand() {
+	if len(os.Args) < 4 {
+		printShelfUsage()
+		os.Exit(1)
+	}
+
+	subcommand := os.Args[3]
+
+	switch subcommand {
+	case "list":
+		shelfList()
+	case "create":
+		shelfCreate()
+	case "rename":
+		shelfRename()
+	case "visibility":
+		shelfVisibility()
+	case "delete":
+		shelfDelete()
+	case "order":
+		shelfOrder()
+	case "add":
+		shelfEntry("POST")
+	case "remove":
+		shelfEntry("DELETE")
+	default:
+		fmt.Printf("Unknown shelf subcommand: %s\n", subcommand)
+		printShelfUsage()
+		os.Exit(1)
+	}
+}
+
+func printShelfUsage() {
+	fmt.Println("Usage: mangahub library shelf <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  list                                        List your shelves")
+	fmt.Println("  create <name> [--visibility=private|public] Create a shelf")
+	fmt.Println("  rename <shelf_id> <name>                    Rename a shelf")
+	fmt.Println("  visibility <shelf_id> <private|public>      Change who can see a shelf")
+	fmt.Println("  delete <shelf_id>                           Delete a shelf (entries stay in your library)")
+	fmt.Println("  order <shelf_id> [<shelf_id> ...]           Set the display order of your shelves")
+	fmt.Println("  add <shelf_id> <manga_id>                   Put a library entry on a shelf")
+	fmt.Println("  remove <shelf_id> <manga_id>                Take a library entry off a shelf")
+}
+
+func shelfList() {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ShelfListResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/shelves", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list shelves: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("You have no shelves yet. Create one with 'mangahub library shelf create <name>'.")
+		return
+	}
+
+	fmt.Println("Your Shelves:")
+	for _, shelf := range data.Items {
+		fmt.Printf("  %d. %s (%d entries, %s)\n", shelf.Position+1, shelf.Name, shelf.EntryCount, shelf.Visibility)
+		fmt.Printf("     ID: %s\n", shelf.ID)
+	}
+}
+
+func shelfCreate() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library shelf create <name> [--visibility=private|public]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var nameParts []string
+	reqBody := climodels.ShelfCreateRequest{}
+	for _, arg := range os.Args[4:] {
+		if strings.HasPrefix(arg, "--visibility=") {
+			reqBody.Visibility = strings.TrimPrefix(arg, "--visibility=")
+		} else {
+			nameParts = append(nameParts, arg)
+		}
+	}
+	reqBody.Name = strings.Join(nameParts, " ")
+
+	var data climodels.ShelfResponse
+	if _, err := api.Do(cliConfig, "POST", "/users/shelves", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to create shelf: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Shelf '%s' created (ID: %s)\n", data.Shelf.Name, data.Shelf.ID)
+}
+
+func shelfRename() {
+	if len(os.Args) < 6 {
+		fmt.Println("Usage: mangahub library shelf rename <shelf_id> <name>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	name := strings.Join(os.Args[5:], " ")
+	reqBody := climodels.ShelfUpdateRequest{Name: &name}
+
+	var data climodels.ShelfResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/shelves/"+url.PathEscape(os.Args[4]), reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to rename shelf: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Shelf renamed to '%s'\n", data.Shelf.Name)
+}
+
+func shelfVisibility() {
+	if len(os.Args) < 6 {
+		fmt.Println("Usage: mangahub library shelf visibility <shelf_id> <private|public>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	visibility := os.Args[5]
+	reqBody := climodels.ShelfUpdateRequest{Visibility: &visibility}
+
+	var data climodels.ShelfResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/shelves/"+url.PathEscape(os.Args[4]), reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to update shelf: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Shelf '%s' is now %s\n", data.Shelf.Name, data.Shelf.Visibility)
+}
+
+func shelfDelete() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library shelf delete <shelf_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", "/users/shelves/"+url.PathEscape(os.Args[4]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to delete shelf: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Shelf deleted")
+}
+
+func shelfOrder() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub library shelf order <shelf_id> [<shelf_id> ...]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	reqBody := climodels.ShelfOrderRequest{ShelfIDs: os.Args[4:]}
+
+	var data climodels.ShelfListResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/shelves/order", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to reorder shelves: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Shelves reordered:")
+	for _, shelf := range data.Items {
+		fmt.Printf("  %d. %s\n", shelf.Position+1, shelf.Name)
+	}
+}
+
+// shelfEntry adds (POST) or removes (DELETE) a library entry on a shelf
+func shelfEntry(method string) {
+	if len(os.Args) < 6 {
+		if method == "POST" {
+			fmt.Println("Usage: mangahub library shelf add <shelf_id> <manga_id>")
+		} else {
+			fmt.Println("Usage: mangahub library shelf remove <shelf_id> <manga_id>")
+		}
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	shelfID := url.PathEscape(os.Args[4])
+	mangaID := os.Args[5]
+
+	var err error
+	if method == "POST" {
+		_, err = api.Do(cliConfig, "POST", "/users/shelves/"+shelfID+"/entries", climodels.ShelfEntryRequest{MangaID: mangaID}, nil)
+	} else {
+		_, err = api.Do(cliConfig, "DELETE", "/users/shelves/"+shelfID+"/entries/"+url.PathEscape(mangaID), nil, nil)
+	}
+	if err != nil {
+		fmt.Printf("❌ Failed to update shelf: %v\n", err)
+		os.Exit(1)
+	}
+
+	if method == "POST" {
+		fmt.Printf("✅ Manga '%s' added to shelf\n", mangaID)
+	} else {
+		fmt.Printf("✅ Manga '%s' removed from shelf\n", mangaID)
+	}
+}
+
+func libraryTag() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub library tag <manga_id> [<tag>,<tag>...]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	mangaID := os.Args[3]
+	reqBody := climodels.TagsUpdateRequest{Tags: []string{}}
+	if len(os.Args) > 4 {
+		for _, tag := range strings.Split(strings.Join(os.Args[4:], " "), ",") {
+			reqBody.Tags = append(reqBody.Tags, strings.TrimSpace(tag))
+		}
+	}
+
+	var data struct {
+		Tags []string `json:"tags"`
+	}
+	if _, err := api.Do(cliConfig, "PUT", "/users/library/"+url.PathEscape(mangaID)+"/tags", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to update tags: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Tags) == 0 {
+		fmt.Printf("✅ Tags cleared for '%s'\n", mangaID)
+		return
+	}
+	fmt.Printf("✅ Tags for '%s': %s\n", mangaID, strings.Join(data.Tags, ", "))
+}
+
+func libraryTags() {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.TagListResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/tags", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list tags: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("You have not tagged any entries yet.")
+		return
+	}
+
+	fmt.Println("Your Tags:")
+	for _, tc := range data.Items {
+		fmt.Printf("  %-24s %d\n", tc.Tag, tc.Count)
+	}
+}
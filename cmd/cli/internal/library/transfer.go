@@ -0,0 +1,161 @@
+package library
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func libraryImport() {
+	usage := "Usage: mangahub library import <file> [--format=mal] [--overwrite] [--dry-run]"
+	if len(os.Args) < 4 {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	filePath := ""
+	queryParams := url.Values{}
+	queryParams.Set("format", "mal")
+	for _, arg := range os.Args[3:] {
+		switch {
+		case strings.HasPrefix(arg, "--format="):
+			queryParams.Set("format", strings.TrimPrefix(arg, "--format="))
+		case arg == "--overwrite":
+			queryParams.Set("overwrite", "true")
+		case arg == "--dry-run":
+			queryParams.Set("dry_run", "true")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Printf("Unknown option: %s\n", arg)
+			fmt.Println(usage)
+			os.Exit(1)
+		default:
+			filePath = arg
+		}
+	}
+
+	if filePath == "" {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		fmt.Printf("Error opening file: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	// Upload the file as multipart form data
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(filePath))
+	if err != nil {
+		fmt.Printf("Error preparing upload: %v\n", err)
+		os.Exit(1)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		fmt.Printf("Error reading file: %v\n", err)
+		os.Exit(1)
+	}
+	writer.Close()
+
+	req, err := http.NewRequest("POST", api.BaseURL(cliConfig)+"/users/library/import?"+queryParams.Encode(), &body)
+	if err != nil {
+		fmt.Printf("Error creating request: %v\n", err)
+		os.Exit(1)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+cliConfig.User.Token)
+
+	var data climodels.ImportResponse
+	if _, err := api.Send(req, &data); err != nil {
+		fmt.Printf("❌ Failed to import library: %v\n", err)
+		os.Exit(1)
+	}
+
+	result := data.Result
+	if result.DryRun {
+		fmt.Printf("Dry run: nothing was written (%d entries in file)\n", result.Total)
+	} else {
+		fmt.Printf("✅ Import finished (%d entries in file)\n", result.Total)
+	}
+	fmt.Printf("  Added:     %d\n", result.Added)
+	fmt.Printf("  Updated:   %d\n", result.Updated)
+	fmt.Printf("  Skipped:   %d\n", len(result.Skipped))
+	fmt.Printf("  Unmatched: %d\n", len(result.Unmatched))
+	fmt.Printf("  Failed:    %d\n", len(result.Failed))
+
+	printImportIssues("Unmatched titles", result.Unmatched)
+	printImportIssues("Failed entries", result.Failed)
+	if len(result.Skipped) > 0 && queryParams.Get("overwrite") == "" {
+		fmt.Println("\nEntries already in your library were skipped; use --overwrite to update them.")
+	}
+}
+
+func libraryExport() {
+	cliConfig := api.MustLoadConfig(true)
+
+	format := "json"
+	output := ""
+	for _, arg := range os.Args[3:] {
+		if strings.HasPrefix(arg, "--format=") {
+			format = strings.TrimPrefix(arg, "--format=")
+		} else if strings.HasPrefix(arg, "--output=") {
+			output = strings.TrimPrefix(arg, "--output=")
+		} else {
+			fmt.Println("Usage: mangahub library export [--format=mal|csv|json] [--output=<file>]")
+			os.Exit(1)
+		}
+	}
+
+	req, err := api.NewRequest(cliConfig, "GET", "/users/library/export?format="+url.QueryEscape(format), nil)
+	if err != nil {
+		fmt.Printf("Error creating request: %v\n", err)
+		os.Exit(1)
+	}
+
+	data, filename, err := api.Download(req)
+	if err != nil {
+		fmt.Printf("❌ Failed to export library: %v\n", err)
+		os.Exit(1)
+	}
+
+	if output == "" {
+		output = filename
+	}
+	if output == "" {
+		output = "mangahub-library." + format
+		if format == "mal" {
+			output = "mangahub-library.xml"
+		}
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		fmt.Printf("Error writing file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Library exported to %s (%d bytes)\n", output, len(data))
+}
+
+func printImportIssues(heading string, issues []climodels.ImportIssue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s:\n", heading)
+	for _, issue := range issues {
+		fmt.Printf("  - %s: %s\n", issue.Title, issue.Reason)
+	}
+}
@@ -27,6 +27,8 @@ func HandleMangaCommand() {
 		mangaGet()
 	case "all":
 		mangaGetAll()
+	case "sync":
+		mangaSync()
 	default:
 		fmt.Printf("Unknown manga subcommand: %s\n", subcommand)
 		printMangaUsage()
@@ -38,8 +40,11 @@ func printMangaUsage() {
 	fmt.Println("Usage: mangahub manga <subcommand> [options]")
 	fmt.Println("\nSubcommands:")
 	fmt.Println("  search               Search for manga by title, author, genre, or status")
+	fmt.Println("                       (--sort=score ranks by community score)")
 	fmt.Println("  get <id>             Get details for a specific manga by ID")
 	fmt.Println("  all                  Get all manga with pagination")
+	fmt.Println("  sync [--full]        Pull catalog changes into the local mirror")
+	fmt.Println("\nsearch and get answer from the local catalog mirror; add --online to ask the server.")
 }
 
 func mangaSearch() {
@@ -49,10 +54,13 @@ func mangaSearch() {
 		os.Exit(1)
 	}
 
+	online := false
 	queryParams := url.Values{}
 	for i := 3; i < len(os.Args); i++ {
 		arg := os.Args[i]
-		if strings.HasPrefix(arg, "--") {
+		if arg == "--online" {
+			online = true
+		} else if strings.HasPrefix(arg, "--") {
 			parts := strings.SplitN(arg[2:], "=", 2)
 			if len(parts) == 2 {
 				queryParams.Add(parts[0], parts[1])
@@ -63,6 +71,22 @@ func mangaSearch() {
 		}
 	}
 
+	if !online {
+		if store, syncedAt := openMirror(cliConfig); store != nil {
+			defer store.Close()
+			mangaList, total, err := store.SearchCatalog(mirrorQuery(queryParams))
+			if err != nil {
+				fmt.Printf("❌ Manga search failed: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Println("Manga Search Results:")
+			printMangaList(mangaList)
+			fmt.Printf("%d match(es) in the local catalog mirror (synced %s); use --online to ask the server.\n",
+				total, syncedAt.Local().Format("2006-01-02 15:04"))
+			return
+		}
+	}
+
 	apiURL := fmt.Sprintf("http://%s:%d/api/v1/manga?%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, queryParams.Encode())
 	resp, err := http.Get(apiURL)
 	if err != nil {
@@ -89,15 +113,7 @@ func mangaSearch() {
 		}
 
 		fmt.Println("Manga Search Results:")
-		for _, m := range apiResp.Data.Items {
-			fmt.Printf("  ID: %s\n", m.ID)
-			fmt.Printf("  Title: %s\n", m.Title)
-			fmt.Printf("  Author: %s\n", m.Author)
-			fmt.Printf("  Genres: %s\n", strings.Join(m.Genres, ", "))
-			fmt.Printf("  Status: %s\n", m.Status)
-			fmt.Printf("  Total Chapters: %d\n", m.TotalChapters)
-			fmt.Printf("  --------------------\n")
-		}
+		printMangaList(apiResp.Data.Items)
 	} else {
 		var apiResp struct {
 			Success bool                    `json:"success"`
@@ -123,6 +139,7 @@ func mangaGet() {
 		os.Exit(1)
 	}
 	mangaID := os.Args[3]
+	online := len(os.Args) > 4 && os.Args[4] == "--online"
 
 	cliConfig, err := config.LoadCLIConfig()
 	if err != nil {
@@ -130,6 +147,20 @@ func mangaGet() {
 		os.Exit(1)
 	}
 
+	// Manga missing from the mirror may be new, so fall through to the server
+	if !online {
+		if store, syncedAt := openMirror(cliConfig); store != nil {
+			m, err := store.CatalogManga(mangaID)
+			store.Close()
+			if err == nil && m != nil {
+				printMangaDetails(m)
+				fmt.Printf("\nFrom the local catalog mirror (synced %s); use --online to ask the server.\n",
+					syncedAt.Local().Format("2006-01-02 15:04"))
+				return
+			}
+		}
+	}
+
 	apiURL := fmt.Sprintf("http://%s:%d/api/v1/manga/%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, mangaID)
 	resp, err := http.Get(apiURL)
 	if err != nil {
@@ -154,17 +185,7 @@ func mangaGet() {
 			os.Exit(1)
 		}
 
-		m := apiResp.Data.Manga
-		fmt.Printf("Manga Details (ID: %s):\n", m.ID)
-		fmt.Printf("  Title: %s\n", m.Title)
-		fmt.Printf("  Author: %s\n", m.Author)
-		fmt.Printf("  Genres: %s\n", strings.Join(m.Genres, ", "))
-		fmt.Printf("  Status: %s\n", m.Status)
-		fmt.Printf("  Total Chapters: %d\n", m.TotalChapters)
-		fmt.Printf("  Description: %s\n", m.Description)
-		fmt.Printf("  Cover Image URL: %s\n", m.CoverImageURL)
-		fmt.Printf("  Created At: %s\n", m.CreatedAt.Format("2006-01-02 15:04:05"))
-		fmt.Printf("  Updated At: %s\n", m.UpdatedAt.Format("2006-01-02 15:04:05"))
+		printMangaDetails(&apiResp.Data.Manga)
 	} else {
 		var apiResp struct {
 			Success bool                    `json:"success"`
@@ -231,15 +252,7 @@ func mangaGetAll() {
 		}
 
 		fmt.Println("All Manga:")
-		for _, m := range apiResp.Data.Items {
-			fmt.Printf("  ID: %s\n", m.ID)
-			fmt.Printf("  Title: %s\n", m.Title)
-			fmt.Printf("  Author: %s\n", m.Author)
-			fmt.Printf("  Genres: %s\n", strings.Join(m.Genres, ", "))
-			fmt.Printf("  Status: %s\n", m.Status)
-			fmt.Printf("  Total Chapters: %d\n", m.TotalChapters)
-			fmt.Printf("  --------------------\n")
-		}
+		printMangaList(apiResp.Data.Items)
 	} else {
 		var apiResp struct {
 			Success bool                    `json:"success"`
@@ -258,3 +271,68 @@ func mangaGetAll() {
 		os.Exit(1)
 	}
 }
+
+// printMangaList prints the summary of each manga in a result list
+func printMangaList(mangaList []climodels.Manga) {
+	for _, m := range mangaList {
+		fmt.Printf("  ID: %s\n", m.ID)
+		fmt.Printf("  Title: %s\n", m.Title)
+		fmt.Printf("  Author: %s\n", m.Author)
+		fmt.Printf("  Genres: %s\n", strings.Join(m.Genres, ", "))
+		fmt.Printf("  Status: %s\n", m.Status)
+		fmt.Printf("  Total Chapters: %d\n", m.TotalChapters)
+		fmt.Printf("  Score: %s\n", formatScore(m.CommunityRating))
+		fmt.Printf("  --------------------\n")
+	}
+}
+
+// printMangaDetails prints every field of a manga
+func printMangaDetails(m *climodels.Manga) {
+	fmt.Printf("Manga Details (ID: %s):\n", m.ID)
+	fmt.Printf("  Title: %s\n", m.Title)
+	fmt.Printf("  Author: %s\n", m.Author)
+	fmt.Printf("  Genres: %s\n", strings.Join(m.Genres, ", "))
+	fmt.Printf("  Status: %s\n", m.Status)
+	fmt.Printf("  Total Chapters: %d\n", m.TotalChapters)
+	fmt.Printf("  Description: %s\n", m.Description)
+	fmt.Printf("  Cover Image URL: %s\n", m.CoverImageURL)
+	fmt.Printf("  Community Score: %s\n", formatScore(m.CommunityRating))
+	if r := m.CommunityRating; r != nil {
+		fmt.Printf("  Mean Rating: %.2f\n", r.Mean)
+		fmt.Println("  Rating Distribution:")
+		printHistogram(r.Histogram)
+	}
+	fmt.Printf("  Created At: %s\n", m.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("  Updated At: %s\n", m.UpdatedAt.Format("2006-01-02 15:04:05"))
+}
+
+// formatScore summarises a community rating as "8.42 (120 ratings)"
+func formatScore(r *climodels.CommunityRating) string {
+	if r == nil {
+		return "not rated"
+	}
+	if r.Count == 1 {
+		return fmt.Sprintf("%.2f (1 rating)", r.Score)
+	}
+	return fmt.Sprintf("%.2f (%d ratings)", r.Score, r.Count)
+}
+
+// printHistogram draws one bar per rating, highest first, scaled to the most common rating
+func printHistogram(histogram [10]int) {
+	const width = 30
+
+	most := 0
+	for _, n := range histogram {
+		if n > most {
+			most = n
+		}
+	}
+
+	for i := len(histogram) - 1; i >= 0; i-- {
+		bar := 0
+		if most > 0 {
+			bar = histogram[i] * width / most
+		}
+		fmt.Printf("    %2d | %-*s %d\n", i+1, width, strings.Repeat("█", bar), histogram[i])
+	}
+}
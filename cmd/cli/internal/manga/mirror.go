@@ -0,0 +1,78 @@
+package manga
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// openMirror opens the local catalog mirror, pulling changes first when it is
+// stale and the server is reachable. It returns nil when the mirror has never
+// been synced, so the caller should ask the server instead.
+func openMirror(cliConfig *config.CLIConfig) (*offline.Store, time.Time) {
+	store, err := offline.OpenConfigured(cliConfig)
+	if err != nil {
+		return nil, time.Time{}
+	}
+
+	syncedAt, err := offline.RefreshCatalog(cliConfig, store)
+	if err != nil && !offline.IsOffline(err) {
+		fmt.Printf("Warning: could not refresh the catalog mirror: %v\n", err)
+	}
+	if syncedAt.IsZero() {
+		store.Close()
+		return nil, time.Time{}
+	}
+
+	return store, syncedAt
+}
+
+// mirrorQuery maps search flags onto a query against the mirror
+func mirrorQuery(params url.Values) climodels.MangaSearchQuery {
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	offset, _ := strconv.Atoi(params.Get("offset"))
+
+	return climodels.MangaSearchQuery{
+		Title:  params.Get("title"),
+		Author: params.Get("author"),
+		Genre:  params.Get("genre"),
+		Status: params.Get("status"),
+		Sort:   params.Get("sort"),
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+func mangaSync() {
+	full := len(os.Args) > 3 && os.Args[3] == "--full"
+
+	cliConfig := api.MustLoadConfig(false)
+
+	store, err := offline.OpenConfigured(cliConfig)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
+	pulled, err := offline.PullCatalog(cliConfig, store, full)
+	if err != nil {
+		fmt.Printf("❌ Failed to sync catalog: %v\n", err)
+		os.Exit(1)
+	}
+
+	_, total, err := store.SearchCatalog(climodels.MangaSearchQuery{Limit: 1})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Catalog mirror synced: %d change(s) pulled, %d manga mirrored.\n", pulled, total)
+}
@@ -45,6 +45,11 @@ func notifyListen() {
 		os.Exit(1)
 	}
 
+	if cliConfig.User.Token == "" {
+		fmt.Println("Error: Not logged in. Please use 'mangahub auth login' first.")
+		os.Exit(1)
+	}
+
 	clientID := uuid.New().String()
 	addr := fmt.Sprintf("%s:%d", cliConfig.Server.Host, cliConfig.Server.UDPPort)
 	udpAddr, _ := net.ResolveUDPAddr("udp", addr)
@@ -62,6 +67,8 @@ func notifyListen() {
 		Timestamp: time.Now(),
 		Data: models.UDPRegisterMessage{
 			ClientID: clientID,
+			Token:    cliConfig.User.Token,
+			UserID:   cliConfig.User.ID,
 			Username: cliConfig.User.Username,
 		},
 	}
@@ -87,12 +94,26 @@ func notifyListen() {
 				continue
 			}
 
+			if msg.Type == models.UDPMessageTypeRegisterFailed {
+				var failed models.UDPRegisterFailedMessage
+				dataBytes, _ := json.Marshal(msg.Data)
+				json.Unmarshal(dataBytes, &failed)
+				fmt.Printf("Error: Registration failed: %s\n", failed.Reason)
+				os.Exit(1)
+			}
+
 			if msg.Type == models.UDPMessageTypeNotification {
 				var n models.UDPNotification
 				dataBytes, _ := json.Marshal(msg.Data)
 				json.Unmarshal(dataBytes, &n)
 
-				fmt.Printf("[%s] 🔔 NEW CHAPTER: %s - Chapter %d released!\n", 
+				// Notifications addressed to this user (reached goals, club reminders)
+				if n.UserID != "" {
+					fmt.Printf("[%s] 🎯 %s\n", time.Now().Format("15:04:05"), n.Message)
+					continue
+				}
+
+				fmt.Printf("[%s] 🔔 NEW CHAPTER: %s - Chapter %s released!\n", 
 					time.Now().Format("15:04:05"), n.MangaTitle, n.ChapterNumber)
 				fmt.Printf("      Message: %s\n", n.Message)
 			}
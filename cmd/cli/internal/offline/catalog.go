@@ -0,0 +1,261 @@
+package offline
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// CatalogMaxAge is how long the catalog mirror answers searches before
+// changes are pulled from the server again
+const CatalogMaxAge = 15 * time.Minute
+
+// catalogPageSize is the number of changes requested per page when pulling
+const catalogPageSize = 500
+
+// sync_state keys for the catalog mirror
+const (
+	stateCatalogToken    = "catalog_changes_token"
+	stateCatalogSyncedAt = "catalog_synced_at"
+)
+
+// CatalogSyncedAt returns when the mirror last pulled from the server,
+// or the zero time if it never has
+func (s *Store) CatalogSyncedAt() (time.Time, error) {
+	value, err := s.state(stateCatalogSyncedAt)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// PullCatalog brings the catalog mirror up to date from the server's change
+// feed and returns how many changes were applied. The feed is read from where
+// the last pull stopped unless full is set or the mirror is empty, in which
+// case it is read from the beginning and replaces the mirror.
+func PullCatalog(cliConfig *config.CLIConfig, store *Store, full bool) (int, error) {
+	since := ""
+	if !full {
+		var err error
+		if since, err = store.state(stateCatalogToken); err != nil {
+			return 0, err
+		}
+	}
+	replace := since == ""
+
+	var changes []climodels.MangaChange
+	for {
+		params := url.Values{}
+		params.Set("since", since)
+		params.Set("limit", strconv.Itoa(catalogPageSize))
+
+		var page climodels.MangaChangesPage
+		if _, err := api.Do(cliConfig, "GET", "/manga/changes?"+params.Encode(), nil, &page); err != nil {
+			return 0, err
+		}
+		changes = append(changes, page.Changes...)
+		since = page.NextSince
+
+		if !page.HasMore {
+			break
+		}
+	}
+
+	if err := store.applyCatalogChanges(changes, replace, since); err != nil {
+		return 0, err
+	}
+	return len(changes), nil
+}
+
+// RefreshCatalog pulls catalog changes when the mirror is older than CatalogMaxAge.
+// It returns when the mirror was last synced, even if the refresh failed.
+func RefreshCatalog(cliConfig *config.CLIConfig, store *Store) (time.Time, error) {
+	syncedAt, err := store.CatalogSyncedAt()
+	if err != nil || (!syncedAt.IsZero() && time.Since(syncedAt) < CatalogMaxAge) {
+		return syncedAt, err
+	}
+
+	if _, err := PullCatalog(cliConfig, store, false); err != nil {
+		return syncedAt, err
+	}
+	return store.CatalogSyncedAt()
+}
+
+// applyCatalogChanges applies pulled changes to the mirror in order and records
+// the feed token to resume from
+func (s *Store) applyCatalogChanges(changes []climodels.MangaChange, replace bool, token string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if replace {
+		if _, err := tx.Exec(`DELETE FROM manga_mirror`); err != nil {
+			return fmt.Errorf("failed to clear catalog mirror: %w", err)
+		}
+	}
+
+	upsert, err := tx.Prepare(`
+		INSERT INTO manga_mirror (id, title, author, genres, status, score, data, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			title = excluded.title, author = excluded.author, genres = excluded.genres,
+			status = excluded.status, score = excluded.score, data = excluded.data,
+			updated_at = excluded.updated_at
+	`)
+	if err != nil {
+		return err
+	}
+	defer upsert.Close()
+
+	for _, change := range changes {
+		if change.Type == "deleted" {
+			if _, err := tx.Exec(`DELETE FROM manga_mirror WHERE id = ?`, change.MangaID); err != nil {
+				return fmt.Errorf("failed to remove manga %s: %w", change.MangaID, err)
+			}
+			continue
+		}
+
+		// A manga without a body was deleted later on; that change follows
+		m := change.Manga
+		if m == nil {
+			continue
+		}
+
+		data, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		genres, err := json.Marshal(m.Genres)
+		if err != nil {
+			return err
+		}
+		var score sql.NullFloat64
+		if m.CommunityRating != nil {
+			score = sql.NullFloat64{Float64: m.CommunityRating.Score, Valid: true}
+		}
+
+		if _, err := upsert.Exec(m.ID, m.Title, m.Author, string(genres), m.Status, score, string(data), m.UpdatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to save manga %s: %w", m.ID, err)
+		}
+	}
+
+	if err := setState(tx, stateCatalogToken, token); err != nil {
+		return err
+	}
+	if err := setState(tx, stateCatalogSyncedAt, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SearchCatalog searches the mirror with the same filters and sort keys as the
+// server's manga search, returning a page of results and the total number of matches
+func (s *Store) SearchCatalog(query climodels.MangaSearchQuery) ([]climodels.Manga, int, error) {
+	whereClauses := []string{"1=1"}
+	args := []interface{}{}
+
+	if query.Title != "" {
+		whereClauses = append(whereClauses, "LOWER(title) LIKE LOWER(?)")
+		args = append(args, "%"+query.Title+"%")
+	}
+	if query.Author != "" {
+		whereClauses = append(whereClauses, "LOWER(author) LIKE LOWER(?)")
+		args = append(args, "%"+query.Author+"%")
+	}
+	if query.Genre != "" {
+		whereClauses = append(whereClauses, "LOWER(genres) LIKE LOWER(?)")
+		args = append(args, "%"+query.Genre+"%")
+	}
+	if query.Status != "" {
+		whereClauses = append(whereClauses, "status = ?")
+		args = append(args, query.Status)
+	}
+
+	whereSQL := strings.Join(whereClauses, " AND ")
+
+	var total int
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM manga_mirror WHERE "+whereSQL, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to search catalog mirror: %w", err)
+	}
+
+	orderBy := "title ASC"
+	switch query.Sort {
+	case "score":
+		orderBy = "score IS NULL, score DESC, title ASC"
+	case "updated":
+		orderBy = "updated_at ASC, id ASC"
+	}
+
+	sqlQuery := fmt.Sprintf("SELECT data FROM manga_mirror WHERE %s ORDER BY %s", whereSQL, orderBy)
+	if query.Limit > 0 {
+		sqlQuery += " LIMIT ? OFFSET ?"
+		args = append(args, query.Limit, query.Offset)
+	}
+
+	rows, err := s.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search catalog mirror: %w", err)
+	}
+	defer rows.Close()
+
+	var mangaList []climodels.Manga
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, 0, err
+		}
+		var m climodels.Manga
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			return nil, 0, err
+		}
+		mangaList = append(mangaList, m)
+	}
+
+	return mangaList, total, rows.Err()
+}
+
+// CatalogManga returns a manga from the mirror, or nil if it is not mirrored
+func (s *Store) CatalogManga(id string) (*climodels.Manga, error) {
+	var data string
+	err := s.db.QueryRow(`SELECT data FROM manga_mirror WHERE id = ?`, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m climodels.Manga
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (s *Store) state(key string) (string, error) {
+	var value string
+	err := s.db.QueryRow(`SELECT value FROM sync_state WHERE key = ?`, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return value, err
+}
+
+func setState(tx *sql.Tx, key, value string) error {
+	_, err := tx.Exec(`
+		INSERT INTO sync_state (key, value) VALUES (?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value
+	`, key, value)
+	return err
+}
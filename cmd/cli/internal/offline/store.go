@@ -0,0 +1,219 @@
+package offline
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// Operation kinds that can be queued while the API is unreachable
+const (
+	KindProgressUpdate = "progress_update"
+	KindLibraryAdd     = "library_add"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS pending_ops (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	manga_id TEXT NOT NULL,
+	payload TEXT NOT NULL,
+	base_version INTEGER NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS progress_cache (
+	user_id TEXT NOT NULL,
+	manga_id TEXT NOT NULL,
+	data TEXT NOT NULL,
+	PRIMARY KEY (user_id, manga_id)
+);
+
+CREATE TABLE IF NOT EXISTS manga_mirror (
+	id TEXT PRIMARY KEY,
+	title TEXT NOT NULL,
+	author TEXT NOT NULL DEFAULT '',
+	genres TEXT NOT NULL DEFAULT '[]',
+	status TEXT NOT NULL DEFAULT '',
+	score REAL,
+	data TEXT NOT NULL,
+	updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync_state (
+	key TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+`
+
+// Op is an API write queued while the server was unreachable
+type Op struct {
+	ID      int64
+	UserID  string
+	Kind    string
+	MangaID string
+	// Payload is the JSON request body to send
+	Payload json.RawMessage
+	// BaseVersion is the progress version the change was made against, 0 if unknown
+	BaseVersion int
+	CreatedAt   time.Time
+	Attempts    int
+	LastError   string
+}
+
+// Store is the CLI's local SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+// DatabasePath returns the local database file configured for the CLI
+func DatabasePath(cliConfig *config.CLIConfig) (string, error) {
+	if cliConfig.Database.Path != "" {
+		return cliConfig.Database.Path, nil
+	}
+
+	configDir, _, err := config.GetCLIConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "data.db"), nil
+}
+
+// Open opens (creating if needed) the local database at path
+func Open(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite3", path+"?_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open local database: %w", err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize local database: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// OpenConfigured opens the local database configured for the CLI
+func OpenConfigured(cliConfig *config.CLIConfig) (*Store, error) {
+	path, err := DatabasePath(cliConfig)
+	if err != nil {
+		return nil, err
+	}
+	return Open(path)
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Enqueue adds an operation to the end of the queue
+func (s *Store) Enqueue(op *Op) error {
+	if op.CreatedAt.IsZero() {
+		op.CreatedAt = time.Now()
+	}
+
+	result, err := s.db.Exec(`
+		INSERT INTO pending_ops (user_id, kind, manga_id, payload, base_version, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, op.UserID, op.Kind, op.MangaID, string(op.Payload), op.BaseVersion, op.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to queue operation: %w", err)
+	}
+
+	op.ID, err = result.LastInsertId()
+	return err
+}
+
+// Pending lists a user's queued operations, oldest first
+func (s *Store) Pending(userID string) ([]Op, error) {
+	rows, err := s.db.Query(`
+		SELECT id, user_id, kind, manga_id, payload, base_version, created_at, attempts, last_error
+		FROM pending_ops
+		WHERE user_id = ?
+		ORDER BY id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queued operations: %w", err)
+	}
+	defer rows.Close()
+
+	var ops []Op
+	for rows.Next() {
+		var op Op
+		var payload string
+		if err := rows.Scan(&op.ID, &op.UserID, &op.Kind, &op.MangaID, &payload,
+			&op.BaseVersion, &op.CreatedAt, &op.Attempts, &op.LastError); err != nil {
+			return nil, fmt.Errorf("failed to read queued operation: %w", err)
+		}
+		op.Payload = json.RawMessage(payload)
+		ops = append(ops, op)
+	}
+
+	return ops, rows.Err()
+}
+
+// Remove deletes an operation from the queue
+func (s *Store) Remove(id int64) error {
+	_, err := s.db.Exec(`DELETE FROM pending_ops WHERE id = ?`, id)
+	return err
+}
+
+// RecordAttempt notes a failed replay of an operation that stays queued
+func (s *Store) RecordAttempt(id int64, lastError string) error {
+	_, err := s.db.Exec(`
+		UPDATE pending_ops SET attempts = attempts + 1, last_error = ? WHERE id = ?
+	`, lastError, id)
+	return err
+}
+
+// CachedProgress returns the last progress seen from the server for a manga,
+// or nil if none has been cached
+func (s *Store) CachedProgress(userID, mangaID string) (*climodels.UserProgress, error) {
+	var data string
+	err := s.db.QueryRow(`
+		SELECT data FROM progress_cache WHERE user_id = ? AND manga_id = ?
+	`, userID, mangaID).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var progress climodels.UserProgress
+	if err := json.Unmarshal([]byte(data), &progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// CacheProgress remembers the progress last seen from the server
+func (s *Store) CacheProgress(userID string, progress *climodels.UserProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+		INSERT INTO progress_cache (user_id, manga_id, data) VALUES (?, ?, ?)
+		ON CONFLICT(user_id, manga_id) DO UPDATE SET data = excluded.data
+	`, userID, progress.MangaID, string(data))
+	return err
+}
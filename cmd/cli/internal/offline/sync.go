@@ -0,0 +1,325 @@
+package offline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// Conflict resolution strategies for sync.conflict_resolution, applied when a
+// queued progress update is replayed after the entry changed on the server
+const (
+	// ResolveLastWriteWins keeps whichever change was made most recently
+	ResolveLastWriteWins = "last_write_wins"
+	// ResolveServerWins discards the queued change
+	ResolveServerWins = "server_wins"
+	// ResolveClientWins overwrites the server with the queued change
+	ResolveClientWins = "client_wins"
+	// ResolveHighestChapter keeps whichever chapter is furthest along
+	ResolveHighestChapter = "highest_chapter"
+)
+
+// Replay outcomes
+const (
+	OutcomeApplied   = "applied"
+	OutcomeDiscarded = "discarded"
+	OutcomeFailed    = "failed"
+)
+
+// Outcome reports what happened to a queued operation during replay
+type Outcome struct {
+	Op      Op
+	Result  string
+	Message string
+}
+
+// ResolutionStrategy returns the configured conflict resolution strategy,
+// falling back to last_write_wins when unset or unknown
+func ResolutionStrategy(cliConfig *config.CLIConfig) string {
+	switch cliConfig.Sync.ConflictResolution {
+	case ResolveServerWins, ResolveClientWins, ResolveHighestChapter:
+		return cliConfig.Sync.ConflictResolution
+	default:
+		return ResolveLastWriteWins
+	}
+}
+
+// IsOffline reports whether err means the API could not be reached, as opposed
+// to the API rejecting the request
+func IsOffline(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// FetchProgress gets the user's progress on a manga from the API
+func FetchProgress(cliConfig *config.CLIConfig, mangaID string) (*climodels.UserProgress, error) {
+	var data struct {
+		Progress climodels.UserProgress `json:"progress"`
+	}
+	if _, err := api.Do(cliConfig, "GET", "/users/progress/"+url.PathEscape(mangaID), nil, &data); err != nil {
+		return nil, err
+	}
+	return &data.Progress, nil
+}
+
+// SendProgressUpdate sends a progress update, guarded by If-Match when version is
+// set. On a version conflict the returned *api.Error carries the current progress.
+func SendProgressUpdate(cliConfig *config.CLIConfig, mangaID string, update climodels.ProgressUpdateRequest, version int) (*climodels.UserProgress, error) {
+	req, err := api.NewRequest(cliConfig, "PUT", "/users/progress/"+url.PathEscape(mangaID), update)
+	if err != nil {
+		return nil, err
+	}
+	if version > 0 {
+		req.Header.Set("If-Match", strconv.Quote(strconv.Itoa(version)))
+	}
+
+	var data struct {
+		Progress climodels.UserProgress `json:"progress"`
+	}
+	if _, err := api.Send(req, &data); err != nil {
+		return nil, err
+	}
+	return &data.Progress, nil
+}
+
+// ConflictingProgress extracts the server's current progress from a version conflict error
+func ConflictingProgress(err error) *climodels.UserProgress {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		return nil
+	}
+
+	var data struct {
+		Progress *climodels.UserProgress `json:"progress"`
+	}
+	if json.Unmarshal(apiErr.Data, &data) != nil {
+		return nil
+	}
+	return data.Progress
+}
+
+// Replay sends the user's queued operations in order. It stops at the first
+// operation that cannot reach the API, leaving it and the rest queued.
+func Replay(cliConfig *config.CLIConfig, store *Store) ([]Outcome, error) {
+	ops, err := store.Pending(cliConfig.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := ResolutionStrategy(cliConfig)
+
+	var outcomes []Outcome
+	for _, op := range ops {
+		var message string
+		var progress *climodels.UserProgress
+		var err error
+		switch op.Kind {
+		case KindProgressUpdate:
+			progress, message, err = replayProgressUpdate(cliConfig, op, strategy)
+		case KindLibraryAdd:
+			message, err = replayLibraryAdd(cliConfig, op)
+		default:
+			err = fmt.Errorf("unknown operation %q", op.Kind)
+		}
+
+		var apiErr *api.Error
+		switch {
+		case err == nil:
+			if rmErr := store.Remove(op.ID); rmErr != nil {
+				return outcomes, rmErr
+			}
+			result := OutcomeApplied
+			if message != "" {
+				result = OutcomeDiscarded
+			}
+			outcomes = append(outcomes, Outcome{Op: op, Result: result, Message: message})
+			if progress != nil {
+				if err := store.CacheProgress(op.UserID, progress); err != nil {
+					return outcomes, fmt.Errorf("failed to cache progress: %w", err)
+				}
+			}
+		case IsOffline(err), errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized:
+			// Nothing later can succeed either; try again on the next connection
+			if recErr := store.RecordAttempt(op.ID, err.Error()); recErr != nil {
+				return outcomes, recErr
+			}
+			return outcomes, err
+		default:
+			// The server refused the change, so replaying it again would not help
+			if rmErr := store.Remove(op.ID); rmErr != nil {
+				return outcomes, rmErr
+			}
+			outcomes = append(outcomes, Outcome{Op: op, Result: OutcomeFailed, Message: err.Error()})
+		}
+	}
+
+	return outcomes, nil
+}
+
+// replayProgressUpdate sends a queued progress update, resolving a version
+// conflict with strategy. It returns the server's progress afterwards; a
+// non-empty message means the update was discarded.
+func replayProgressUpdate(cliConfig *config.CLIConfig, op Op, strategy string) (*climodels.UserProgress, string, error) {
+	var update climodels.ProgressUpdateRequest
+	if err := json.Unmarshal(op.Payload, &update); err != nil {
+		return nil, "", fmt.Errorf("invalid queued update: %w", err)
+	}
+
+	// Without a base version a conflict cannot be detected, so the update is applied as is
+	version := op.BaseVersion
+	switch strategy {
+	case ResolveClientWins:
+		version = 0
+	case ResolveHighestChapter:
+		update.OnConflict = "highest_chapter"
+	}
+
+	progress, err := SendProgressUpdate(cliConfig, op.MangaID, update, version)
+	if current := ConflictingProgress(err); current != nil {
+		if strategy == ResolveLastWriteWins && !changedAfter(current, op.CreatedAt) {
+			progress, err = SendProgressUpdate(cliConfig, op.MangaID, update, 0)
+		} else {
+			return current, fmt.Sprintf("changed on the server since it was queued; kept chapter %g (%s)",
+				current.CurrentChapter, current.Status), nil
+		}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return progress, "", nil
+}
+
+// replayLibraryAdd sends a queued library addition. A manga added from another
+// device in the meantime is left as it is on the server.
+func replayLibraryAdd(cliConfig *config.CLIConfig, op Op) (string, error) {
+	var add climodels.LibraryAddRequest
+	if err := json.Unmarshal(op.Payload, &add); err != nil {
+		return "", fmt.Errorf("invalid queued addition: %w", err)
+	}
+
+	_, err := api.Do(cliConfig, "POST", "/users/library", add, nil)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+		return "already in your library on the server", nil
+	}
+	return "", err
+}
+
+// changedAfter reports whether the server's progress was written after t
+func changedAfter(progress *climodels.UserProgress, t time.Time) bool {
+	updatedAt, err := time.Parse(time.RFC3339Nano, progress.UpdatedAt)
+	return err == nil && updatedAt.After(t)
+}
+
+// Enqueue queues an operation for the logged-in user in the local database
+func Enqueue(cliConfig *config.CLIConfig, kind, mangaID string, payload interface{}, baseVersion int) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	store, err := OpenConfigured(cliConfig)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	return store.Enqueue(&Op{
+		UserID:      cliConfig.User.ID,
+		Kind:        kind,
+		MangaID:     mangaID,
+		Payload:     data,
+		BaseVersion: baseVersion,
+	})
+}
+
+// AutoSync replays queued operations before a command talks to the API, when
+// sync.auto_sync is enabled. It stays quiet unless something was replayed.
+func AutoSync() {
+	cliConfig, err := config.LoadCLIConfig()
+	if err != nil || cliConfig.User.Token == "" || !cliConfig.Sync.AutoSync {
+		return
+	}
+
+	// Don't create the local database just to find it empty
+	path, err := DatabasePath(cliConfig)
+	if err != nil {
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		return
+	}
+
+	store, err := Open(path)
+	if err != nil {
+		return
+	}
+	defer store.Close()
+
+	if ops, err := store.Pending(cliConfig.User.ID); err != nil || len(ops) == 0 {
+		return
+	}
+
+	outcomes, _ := Replay(cliConfig, store)
+	if len(outcomes) > 0 {
+		fmt.Printf("🔄 Synced %d queued operation(s):\n", len(outcomes))
+		PrintOutcomes(outcomes)
+		fmt.Println()
+	}
+}
+
+// PrintOutcomes prints one line per replayed operation
+func PrintOutcomes(outcomes []Outcome) {
+	for _, o := range outcomes {
+		icon := "✅"
+		switch o.Result {
+		case OutcomeDiscarded:
+			icon = "⚠️"
+		case OutcomeFailed:
+			icon = "❌"
+		}
+		line := fmt.Sprintf("  %s %s %s: %s", icon, o.Op.MangaID, Describe(o.Op), o.Result)
+		if o.Message != "" {
+			line += " (" + o.Message + ")"
+		}
+		fmt.Println(line)
+	}
+}
+
+// Describe summarizes a queued operation for display
+func Describe(op Op) string {
+	switch op.Kind {
+	case KindProgressUpdate:
+		var update climodels.ProgressUpdateRequest
+		if json.Unmarshal(op.Payload, &update) == nil {
+			desc := "progress →"
+			if update.CurrentChapter != nil {
+				desc += fmt.Sprintf(" ch. %g", *update.CurrentChapter)
+			}
+			if update.Status != "" {
+				desc += " " + update.Status
+			}
+			if update.Rating != nil {
+				desc += fmt.Sprintf(" ★%d", *update.Rating)
+			}
+			return desc
+		}
+	case KindLibraryAdd:
+		var add climodels.LibraryAddRequest
+		if json.Unmarshal(op.Payload, &add) == nil {
+			return fmt.Sprintf("library add (%s, ch. %g)", add.Status, add.CurrentChapter)
+		}
+	}
+	return op.Kind
+}
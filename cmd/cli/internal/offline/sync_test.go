@@ -0,0 +1,170 @@
+package offline_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// progressServer mimics PUT /users/progress/:manga_id, including If-Match
+// version checks and the highest_chapter conflict policy
+type progressServer struct {
+	mu       sync.Mutex
+	progress climodels.UserProgress
+}
+
+func (s *progressServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodPut || r.URL.Path != "/api/v1/users/progress/"+s.progress.MangaID {
+		http.NotFound(w, r)
+		return
+	}
+
+	var update climodels.ProgressUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		unquoted, _ := strconv.Unquote(ifMatch)
+		if version, _ := strconv.Atoi(unquoted); version != s.progress.Version {
+			if update.OnConflict != "highest_chapter" {
+				w.WriteHeader(http.StatusConflict)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"success": false,
+					"data":    map[string]interface{}{"progress": s.progress},
+					"error":   map[string]string{"code": "VERSION_CONFLICT", "message": "progress has changed"},
+				})
+				return
+			}
+			if update.CurrentChapter != nil && *update.CurrentChapter < s.progress.CurrentChapter {
+				update.CurrentChapter = nil
+				update.Status = ""
+			}
+		}
+	}
+
+	if update.CurrentChapter != nil {
+		s.progress.CurrentChapter = *update.CurrentChapter
+	}
+	if update.Status != "" {
+		s.progress.Status = update.Status
+	}
+	if update.Rating != nil {
+		s.progress.Rating = update.Rating
+	}
+	s.progress.Version++
+	s.progress.UpdatedAt = time.Now().UTC().Format(time.RFC3339Nano)
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    map[string]interface{}{"progress": s.progress},
+	})
+}
+
+func TestReplayProgressUpdates(t *testing.T) {
+	queuedAt := time.Now().Add(-time.Hour)
+	chapter := func(n float64) *float64 { return &n }
+
+	tests := []struct {
+		name     string
+		strategy string
+		// Server progress when the queue is replayed, at version 3
+		serverChapter   float64
+		serverUpdatedAt time.Time
+		// Queued update, made against baseVersion
+		update      climodels.ProgressUpdateRequest
+		baseVersion int
+		result      string
+		chapter     float64
+		status      string
+	}{
+		{"last write wins, queued change is newer", offline.ResolveLastWriteWins, 8, queuedAt.Add(-time.Hour),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12)}, 2, offline.OutcomeApplied, 12, "reading"},
+		{"last write wins, server change is newer", offline.ResolveLastWriteWins, 20, queuedAt.Add(time.Minute),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12)}, 2, offline.OutcomeDiscarded, 20, "reading"},
+		{"server wins", offline.ResolveServerWins, 8, queuedAt.Add(-time.Hour),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12)}, 2, offline.OutcomeDiscarded, 8, "reading"},
+		{"client wins", offline.ResolveClientWins, 20, queuedAt.Add(time.Minute),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12)}, 2, offline.OutcomeApplied, 12, "reading"},
+		{"highest chapter, server is further", offline.ResolveHighestChapter, 20, queuedAt.Add(time.Minute),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12), Status: "on_hold"}, 2, offline.OutcomeApplied, 20, "reading"},
+		{"highest chapter, queued change is further", offline.ResolveHighestChapter, 8, queuedAt.Add(time.Minute),
+			climodels.ProgressUpdateRequest{CurrentChapter: chapter(12)}, 2, offline.OutcomeApplied, 12, "reading"},
+		{"status only keeps the chapter", offline.ResolveLastWriteWins, 20, queuedAt.Add(-time.Hour),
+			climodels.ProgressUpdateRequest{Status: "on_hold"}, 3, offline.OutcomeApplied, 20, "on_hold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &progressServer{progress: climodels.UserProgress{
+				UserID:         "user-1",
+				MangaID:        "manga-001",
+				CurrentChapter: tt.serverChapter,
+				Status:         "reading",
+				Version:        3,
+				UpdatedAt:      tt.serverUpdatedAt.UTC().Format(time.RFC3339Nano),
+			}}
+			httpServer := httptest.NewServer(server)
+			defer httpServer.Close()
+
+			serverURL, _ := url.Parse(httpServer.URL)
+			port, _ := strconv.Atoi(serverURL.Port())
+			cliConfig := &config.CLIConfig{
+				Server:   config.ServerConfig{Host: serverURL.Hostname(), HTTPPort: port},
+				Database: config.DatabaseConfig{Path: filepath.Join(t.TempDir(), "data.db")},
+				User:     config.UserConfig{ID: "user-1", Token: "token"},
+				Sync:     config.SyncConfig{ConflictResolution: tt.strategy},
+			}
+
+			store, err := offline.OpenConfigured(cliConfig)
+			if err != nil {
+				t.Fatalf("Failed to open local database: %v", err)
+			}
+			defer store.Close()
+
+			payload, _ := json.Marshal(tt.update)
+			op := &offline.Op{UserID: "user-1", Kind: offline.KindProgressUpdate, MangaID: "manga-001",
+				Payload: payload, BaseVersion: tt.baseVersion, CreatedAt: queuedAt}
+			if err := store.Enqueue(op); err != nil {
+				t.Fatalf("Failed to queue update: %v", err)
+			}
+
+			outcomes, err := offline.Replay(cliConfig, store)
+			if err != nil {
+				t.Fatalf("Replay failed: %v", err)
+			}
+			if len(outcomes) != 1 || outcomes[0].Result != tt.result {
+				t.Fatalf("Expected one %s outcome, got %+v", tt.result, outcomes)
+			}
+
+			if server.progress.CurrentChapter != tt.chapter || server.progress.Status != tt.status {
+				t.Errorf("Expected the server at chapter %g (%s), got %g (%s)",
+					tt.chapter, tt.status, server.progress.CurrentChapter, server.progress.Status)
+			}
+
+			// Whatever won, the local cache ends up matching the server
+			cached, err := store.CachedProgress("user-1", "manga-001")
+			if err != nil || cached == nil || cached.CurrentChapter != tt.chapter || cached.Status != tt.status {
+				t.Errorf("Expected cached chapter %g (%s), got %+v (%v)", tt.chapter, tt.status, cached, err)
+			}
+
+			if pending, _ := store.Pending("user-1"); len(pending) != 0 {
+				t.Errorf("Expected the queue to be empty, got %d operations", len(pending))
+			}
+		})
+	}
+}
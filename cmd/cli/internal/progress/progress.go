@@ -1,15 +1,15 @@
 package progress
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
 	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
 )
 
@@ -24,6 +24,10 @@ func HandleProgressCommand() {
 	switch subcommand {
 	case "update":
 		progressUpdate()
+	case "reread":
+		progressReread()
+	case "reads":
+		progressReads()
 	default:
 		fmt.Printf("Unknown progress subcommand: %s\n", subcommand)
 		printProgressUsage()
@@ -34,71 +38,45 @@ func HandleProgressCommand() {
 func printProgressUsage() {
 	fmt.Println("Usage: mangahub progress <subcommand> [options]")
 	fmt.Println("\nSubcommands:")
-	fmt.Println("  update <manga_id> [--chapter=<chapter>] [--status=<status>] [--rating=<rating>]")
+	fmt.Println("  update <manga_id> [--chapter=<chapter>] [--status=<status>] [--rating=<rating>] [--merge]")
+	fmt.Println("                       (--merge keeps the highest chapter if another device updated first)")
+	fmt.Println("  reread <manga_id>    Start reading a completed series again")
+	fmt.Println("  reads <manga_id>     List your read-throughs of a series")
 }
 
 func progressUpdate() {
 	if len(os.Args) < 4 {
-		fmt.Println("Usage: mangahub progress update <manga_id> [--chapter=<chapter>] [--status=<status>] [--rating=<rating>]")
+		fmt.Println("Usage: mangahub progress update <manga_id> [--chapter=<chapter>] [--status=<status>] [--rating=<rating>] [--merge]")
 		os.Exit(1)
 	}
 
-	cliConfig, err := config.LoadCLIConfig()
-	if err != nil {
-		fmt.Printf("Error loading config: %v\n", err)
-		os.Exit(1)
-	}
-
-	if cliConfig.User.Token == "" {
-		fmt.Println("Error: Not logged in. Please use 'mangahub auth login' first.")
-		os.Exit(1)
-	}
+	cliConfig := api.MustLoadConfig(true)
 
 	mangaID := os.Args[3]
 
-	// 1. Fetch manga details to check if total chapters is N/A
-	mangaURL := fmt.Sprintf("http://%s:%d/api/v1/manga/%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, mangaID)
-	client := &http.Client{}
-	mangaResp, err := client.Get(mangaURL)
+	store, err := offline.OpenConfigured(cliConfig)
 	if err != nil {
-		fmt.Printf("Error fetching manga details: %v\n", err)
-		os.Exit(1)
-	}
-	defer mangaResp.Body.Close()
-
-	if mangaResp.StatusCode != http.StatusOK {
-		fmt.Printf("Error: Manga not found or server error (%d)\n", mangaResp.StatusCode)
-		os.Exit(1)
-	}
-
-	var apiResp struct {
-		Success bool                          `json:"success"`
-		Data    climodels.MangaDetailResponse `json:"data"`
-	}
-	if err := json.NewDecoder(mangaResp.Body).Decode(&apiResp); err != nil {
-		fmt.Printf("Error decoding manga details: %v\n", err)
-		os.Exit(1)
+		fmt.Printf("Warning: local database unavailable: %v\n", err)
+	} else {
+		defer store.Close()
 	}
-	mangaDetail := apiResp.Data
 
-	// 2. Fetch current progress to get status if not provided
+	// Fetch current progress to get status if not provided, and its version so
+	// the update is rejected if another device writes in between. While offline,
+	// fall back to the progress last seen from the server.
 	currentStatus := ""
-	apiURL := fmt.Sprintf("http://%s:%d/api/v1/users/progress/%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, mangaID)
-	getReq, _ := http.NewRequest("GET", apiURL, nil)
-	getReq.Header.Set("Authorization", "Bearer "+cliConfig.User.Token)
-
-	getResp, err := client.Do(getReq)
-	if err == nil && getResp.StatusCode == http.StatusOK {
-		var statusResp struct {
-			Success bool `json:"success"`
-			Data    struct {
-				Progress climodels.UserProgress `json:"progress"`
-			}
-		}
-		if err := json.NewDecoder(getResp.Body).Decode(&statusResp); err == nil {
-			currentStatus = statusResp.Data.Progress.Status
+	currentVersion := 0
+	current, fetchErr := offline.FetchProgress(cliConfig, mangaID)
+	if fetchErr == nil && store != nil {
+		if err := store.CacheProgress(cliConfig.User.ID, current); err != nil {
+			fmt.Printf("Warning: failed to cache progress locally: %v\n", err)
 		}
-		getResp.Body.Close()
+	} else if offline.IsOffline(fetchErr) && store != nil {
+		current, _ = store.CachedProgress(cliConfig.User.ID, mangaID)
+	}
+	if current != nil {
+		currentStatus = current.Status
+		currentVersion = current.Version
 	}
 
 	reqBody := climodels.ProgressUpdateRequest{}
@@ -107,12 +85,8 @@ func progressUpdate() {
 	// Default values or from positional argument
 	if len(os.Args) > 4 {
 		// Check if the 4th argument is a number (chapter)
-		if val, err := strconv.Atoi(os.Args[4]); err == nil {
-			if mangaDetail.Manga.TotalChapters <= 0 && val > 0 {
-				fmt.Printf("❌ Cannot update chapter to %d because total chapters is N/A.\n", val)
-				os.Exit(1)
-			}
-			reqBody.CurrentChapter = val
+		if val, err := strconv.ParseFloat(os.Args[4], 64); err == nil {
+			reqBody.CurrentChapter = &val
 		}
 	}
 
@@ -120,16 +94,12 @@ func progressUpdate() {
 	for i := 4; i < len(os.Args); i++ {
 		arg := os.Args[i]
 		if strings.HasPrefix(arg, "--chapter=") {
-			chapter, err := strconv.Atoi(strings.TrimPrefix(arg, "--chapter="))
+			chapter, err := strconv.ParseFloat(strings.TrimPrefix(arg, "--chapter="), 64)
 			if err != nil {
 				fmt.Printf("Error: Invalid chapter number: %v\n", err)
 				os.Exit(1)
 			}
-			if mangaDetail.Manga.TotalChapters <= 0 && chapter > 0 {
-				fmt.Printf("❌ Cannot update chapter to %d because total chapters is N/A.\n", chapter)
-				os.Exit(1)
-			}
-			reqBody.CurrentChapter = chapter
+			reqBody.CurrentChapter = &chapter
 		} else if strings.HasPrefix(arg, "--status=") {
 			reqBody.Status = strings.TrimPrefix(arg, "--status=")
 		} else if strings.HasPrefix(arg, "--rating=") {
@@ -139,45 +109,107 @@ func progressUpdate() {
 				os.Exit(1)
 			}
 			rating = &r
+		} else if arg == "--merge" {
+			reqBody.OnConflict = "highest_chapter"
 		}
 	}
 	reqBody.Rating = rating
 
-	jsonReqBody, _ := json.Marshal(reqBody)
+	err = fetchErr
+	if !offline.IsOffline(err) {
+		var updated *climodels.UserProgress
+		updated, err = offline.SendProgressUpdate(cliConfig, mangaID, reqBody, currentVersion)
+		if err == nil {
+			if store != nil {
+				if err := store.CacheProgress(cliConfig.User.ID, updated); err != nil {
+					fmt.Printf("Warning: failed to cache progress locally: %v\n", err)
+				}
+			}
+			fmt.Printf("✅ Progress for manga '%s' updated successfully!\n", mangaID)
+			return
+		}
+	}
 
-	apiURL = fmt.Sprintf("http://%s:%d/api/v1/users/progress/%s", cliConfig.Server.Host, cliConfig.Server.HTTPPort, mangaID)
-	req, err := http.NewRequest("PUT", apiURL, bytes.NewBuffer(jsonReqBody))
-	if err != nil {
-		fmt.Printf("Error creating request: %v\n", err)
+	if offline.IsOffline(err) {
+		if err := offline.Enqueue(cliConfig, offline.KindProgressUpdate, mangaID, reqBody, currentVersion); err != nil {
+			fmt.Printf("Error: server unreachable and the update could not be queued: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("📥 Server unreachable; progress update for '%s' queued.\n", mangaID)
+		fmt.Println("   It will be sent on the next successful connection (see 'mangahub sync status').")
+		return
+	}
+
+	if current := offline.ConflictingProgress(err); current != nil {
+		fmt.Printf("❌ Progress was changed elsewhere while updating: now at chapter %g (%s).\n", current.CurrentChapter, current.Status)
+		fmt.Println("   Run the update again, or add --merge to keep whichever chapter is highest.")
 		os.Exit(1)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+cliConfig.User.Token)
+	fmt.Printf("❌ Failed to update progress: %v\n", err)
+	os.Exit(1)
+}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		fmt.Printf("Error connecting to API: %v\n", err)
+func progressReread() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub progress reread <manga_id>")
 		os.Exit(1)
 	}
-	defer resp.Body.Close()
+	mangaID := os.Args[3]
 
-	if resp.StatusCode == http.StatusOK {
-		fmt.Printf("✅ Progress for manga '%s' updated successfully!\n", mangaID)
-	} else {
-		var apiResp struct {
-			Success bool                    `json:"success"`
-			Data    interface{}             `json:"data"`
-			Error   climodels.ErrorResponse `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-			fmt.Printf("Error decoding API error response: %v\n", err)
-			os.Exit(1)
+	cliConfig := api.MustLoadConfig(true)
+
+	var data struct {
+		Progress climodels.UserProgress `json:"progress"`
+	}
+	if _, err := api.Do(cliConfig, "POST", "/users/library/"+url.PathEscape(mangaID)+"/reread", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to start reread: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Started reread #%d of '%s'. Your previous read-through was saved.\n", data.Progress.RereadCount, mangaID)
+}
+
+func progressReads() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub progress reads <manga_id>")
+		os.Exit(1)
+	}
+	mangaID := os.Args[3]
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data struct {
+		Items []climodels.ReadThrough `json:"items"`
+	}
+	if _, err := api.Do(cliConfig, "GET", "/users/library/"+url.PathEscape(mangaID)+"/reads", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list read-throughs: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Printf("You have not started '%s' yet.\n", mangaID)
+		return
+	}
+
+	fmt.Printf("Read-throughs of '%s':\n", mangaID)
+	for _, rt := range data.Items {
+		label := fmt.Sprintf("#%d", rt.Number)
+		if rt.Current {
+			label += " (current)"
 		}
-		errMsg := apiResp.Error.Message
-		if errMsg == "" {
-			errMsg = fmt.Sprintf("API returned status %d", resp.StatusCode)
+		fmt.Printf("  %s\n", label)
+		fmt.Printf("    Started: %s\n", formatDate(rt.StartedAt))
+		fmt.Printf("    Finished: %s\n", formatDate(rt.CompletedAt))
+		fmt.Printf("    Chapter: %g\n", rt.Chapter)
+		if rt.Rating != nil {
+			fmt.Printf("    Rating: %d/10\n", *rt.Rating)
 		}
-		fmt.Printf("❌ Failed to update progress: %s\n", errMsg)
-		os.Exit(1)
 	}
 }
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02")
+}
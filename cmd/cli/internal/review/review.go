@@ -0,0 +1,208 @@
+package review
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func HandleReviewCommand() {
+	if len(os.Args) < 3 {
+		printReviewUsage()
+		os.Exit(1)
+	}
+
+	subcommand := os.Args[2]
+
+	switch subcommand {
+	case "write":
+		reviewWrite()
+	case "list":
+		reviewList()
+	case "mine":
+		reviewMine()
+	case "delete":
+		reviewDelete()
+	case "helpful":
+		reviewHelpful()
+	case "help":
+		printReviewUsage()
+	default:
+		fmt.Printf("Unknown review subcommand: %s\n", subcommand)
+		printReviewUsage()
+		os.Exit(1)
+	}
+}
+
+func printReviewUsage() {
+	fmt.Println("Usage: mangahub review <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  write <manga_id> <text> [--spoiler]         Write or replace your review of a library entry")
+	fmt.Println("  list <manga_id> [--sort=newest|helpful] [--spoilers] [--limit=N] [--offset=N]")
+	fmt.Println("                                              List a manga's reviews (spoilers hidden by default)")
+	fmt.Println("  mine                                        List your own reviews")
+	fmt.Println("  delete <manga_id>                           Delete your review of a manga")
+	fmt.Println("  helpful <review_id> [--undo]                Mark (or unmark) a review as helpful")
+}
+
+func reviewWrite() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub review write <manga_id> <text> [--spoiler]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	mangaID := os.Args[3]
+	reqBody := climodels.ReviewRequest{}
+	var words []string
+	for _, arg := range os.Args[4:] {
+		if arg == "--spoiler" {
+			reqBody.Spoiler = true
+		} else {
+			words = append(words, arg)
+		}
+	}
+	reqBody.Body = strings.Join(words, " ")
+
+	var data climodels.ReviewResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/library/"+url.PathEscape(mangaID)+"/review", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to save review: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Review of '%s' saved (ID: %s)\n", data.Review.MangaTitle, data.Review.ID)
+}
+
+func reviewList() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub review list <manga_id> [--sort=newest|helpful] [--spoilers] [--limit=N] [--offset=N]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(false)
+
+	mangaID := os.Args[3]
+	params := url.Values{}
+	showSpoilers := false
+	for _, arg := range os.Args[4:] {
+		name, value, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--sort":
+			params.Set("sort", value)
+		case "--limit":
+			params.Set("limit", value)
+		case "--offset":
+			params.Set("offset", value)
+		case "--spoilers":
+			showSpoilers = true
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			os.Exit(1)
+		}
+	}
+
+	path := "/manga/" + url.PathEscape(mangaID) + "/reviews"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var data climodels.ReviewListResponse
+	meta, err := api.Do(cliConfig, "GET", path, nil, &data)
+	if err != nil {
+		fmt.Printf("❌ Failed to list reviews: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("No reviews yet.")
+		return
+	}
+
+	fmt.Printf("Reviews of %s (%d total):\n", data.Items[0].MangaTitle, meta.Total)
+	for _, review := range data.Items {
+		printReview(review, review.Username, showSpoilers)
+	}
+}
+
+func reviewMine() {
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.ReviewListResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/reviews", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list reviews: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Println("You have not written any reviews yet.")
+		return
+	}
+
+	fmt.Println("Your Reviews:")
+	for _, review := range data.Items {
+		printReview(review, review.MangaTitle, true)
+	}
+}
+
+func printReview(review climodels.Review, heading string, showSpoilers bool) {
+	fmt.Println()
+	line := heading
+	if review.Rating != nil {
+		line += fmt.Sprintf(" - %d/10", *review.Rating)
+	}
+	fmt.Printf("  %s (%d found this helpful, %s)\n", line, review.HelpfulCount, review.UpdatedAt.Format("2006-01-02"))
+	if review.Spoiler && !showSpoilers {
+		fmt.Println("    [Spoiler hidden - use --spoilers to show]")
+	} else {
+		if review.Spoiler {
+			fmt.Println("    [Spoiler]")
+		}
+		for _, paragraph := range strings.Split(review.Body, "\n") {
+			fmt.Printf("    %s\n", paragraph)
+		}
+	}
+	fmt.Printf("    ID: %s\n", review.ID)
+}
+
+func reviewDelete() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub review delete <manga_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", "/users/library/"+url.PathEscape(os.Args[3])+"/review", nil, nil); err != nil {
+		fmt.Printf("❌ Failed to delete review: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Review deleted")
+}
+
+func reviewHelpful() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub review helpful <review_id> [--undo]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	method := "POST"
+	if len(os.Args) > 4 && os.Args[4] == "--undo" {
+		method = "DELETE"
+	}
+
+	var data climodels.ReviewResponse
+	if _, err := api.Do(cliConfig, method, "/users/reviews/"+url.PathEscape(os.Args[3])+"/helpful", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to update vote: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Vote recorded (%d found this helpful)\n", data.Review.HelpfulCount)
+}
@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func fetchAchievements(cliConfig *config.CLIConfig) climodels.AchievementListResponse {
+	var data climodels.AchievementListResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/achievements", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list achievements: %v\n", err)
+		os.Exit(1)
+	}
+	return data
+}
+
+// printBadges prints the unlocked achievements only
+func printBadges(achievements climodels.AchievementListResponse) {
+	fmt.Printf("Your Badges (%d/%d):\n", achievements.Unlocked, len(achievements.Items))
+	for _, achievement := range achievements.Items {
+		if achievement.Unlocked {
+			fmt.Printf("  🏆 %s - %s\n", achievement.Name, achievement.Description)
+		}
+	}
+}
+
+func listAchievements() {
+	cliConfig := api.MustLoadConfig(true)
+
+	achievements := fetchAchievements(cliConfig)
+
+	fmt.Printf("Achievements (%d/%d unlocked):\n", achievements.Unlocked, len(achievements.Items))
+	for _, achievement := range achievements.Items {
+		if achievement.Unlocked {
+			fmt.Printf("  🏆 %s - %s (unlocked %s)\n", achievement.Name, achievement.Description,
+				achievement.UnlockedAt.Local().Format("2006-01-02"))
+			continue
+		}
+		fmt.Printf("  🔒 %s - %s: %d/%d\n", achievement.Name, achievement.Description, achievement.Current, achievement.Target)
+	}
+}
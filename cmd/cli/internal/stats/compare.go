@@ -0,0 +1,59 @@
+package stats
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func compareStats() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub stats compare <username>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.CompatibilityResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/"+url.PathEscape(os.Args[3])+"/compatibility", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to compare with %s: %v\n", os.Args[3], err)
+		os.Exit(1)
+	}
+	result := data.Compatibility
+
+	fmt.Printf("--- Taste Compatibility with %s ---\n", result.Username)
+	if result.Score != nil {
+		fmt.Printf("Score:               %d/100\n", *result.Score)
+	} else {
+		fmt.Println("Score:               not enough in common yet")
+	}
+	fmt.Printf("Shared Titles:       %d (%d rated by both)\n", result.SharedTitles, result.RatedInCommon)
+	fmt.Printf("Rating Correlation:  %s\n", formatComponent(result.RatingCorrelation, "%+.2f", 1))
+	fmt.Printf("Genre Overlap:       %s\n", formatComponent(result.GenreOverlap, "%.0f%%", 100))
+	fmt.Printf("Shared Completions:  %d (%s of all completions)\n", result.SharedCompletions, formatComponent(result.CompletionOverlap, "%.0f%%", 100))
+
+	printTitleComparisons("You agree on", result.Agreements)
+	printTitleComparisons("You disagree on", result.Disagreements)
+}
+
+// formatComponent formats an optional score component multiplied by scale
+func formatComponent(value *float64, format string, scale float64) string {
+	if value == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf(format, *value*scale)
+}
+
+func printTitleComparisons(heading string, titles []climodels.TitleComparison) {
+	if len(titles) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s:\n", heading)
+	for _, title := range titles {
+		fmt.Printf("  %-40s you %2d/10, them %2d/10\n", title.Title, title.MyRating, title.TheirRating)
+	}
+}
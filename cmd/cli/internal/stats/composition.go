@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// defaultCompositionRows is how many genres and authors are listed by default
+const defaultCompositionRows = 10
+
+func viewComposition() {
+	top := defaultCompositionRows
+	for _, arg := range os.Args[3:] {
+		value, ok := strings.CutPrefix(arg, "--top=")
+		n, err := strconv.Atoi(value)
+		if !ok || err != nil || n < 1 {
+			fmt.Println("Usage: mangahub stats composition [--top=<rows>]")
+			os.Exit(1)
+		}
+		top = n
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	var data climodels.LibraryComposition
+	if _, err := api.Do(cliConfig, "GET", "/users/stats/composition", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get library composition: %v\n", err)
+		os.Exit(1)
+	}
+
+	if data.Titles == 0 {
+		fmt.Println("Your library is empty.")
+		return
+	}
+
+	fmt.Printf("--- Your Library (%d titles) ---\n", data.Titles)
+
+	fmt.Println()
+	PrintCompositionTable("Genre", data.Genres, top)
+	fmt.Println()
+	PrintCompositionTable("Author", data.Authors, top)
+	fmt.Println()
+	PrintCompositionTable("Publication", data.PublicationStatuses, 0)
+	fmt.Println()
+	PrintRatingHistogram(data.Ratings.Counts, data.Ratings.Rated, data.Ratings.AverageRating)
+	fmt.Println("\nCompleted and Dropped are shares of the titles you started (not plan to read).")
+}
+
+// PrintCompositionTable prints one row per group, up to top rows (all when top is 0)
+func PrintCompositionTable(title string, groups []climodels.CompositionGroup, top int) {
+	if len(groups) == 0 {
+		fmt.Printf("%s: none\n", title)
+		return
+	}
+
+	width := len(title)
+	shown := groups
+	if top > 0 && len(shown) > top {
+		shown = shown[:top]
+	}
+	for _, group := range shown {
+		width = max(width, len([]rune(group.Name)))
+	}
+	width = min(width, 30)
+
+	fmt.Printf("%-*s  %6s  %8s  %6s  %9s  %7s\n", width, title, "Titles", "Chapters", "Rating", "Completed", "Dropped")
+	for _, group := range shown {
+		name := []rune(group.Name)
+		if len(name) > width {
+			name = append(name[:width-1], '…')
+		}
+		fmt.Printf("%-*s  %6d  %8d  %6s  %9s  %7s\n", width, string(name), group.Titles, group.ChaptersRead,
+			formatRating(group.AverageRating), formatRate(group.CompletionRate), formatRate(group.DroppedRate))
+	}
+	if len(shown) < len(groups) {
+		fmt.Printf("... and %d more\n", len(groups)-len(shown))
+	}
+}
+
+// PrintRatingHistogram prints a bar per rating from 10 down to 1
+func PrintRatingHistogram(counts []int, rated int, average *float64) {
+	if rated == 0 {
+		fmt.Println("Ratings: none yet")
+		return
+	}
+
+	fmt.Printf("Ratings (%d rated, average %s):\n", rated, formatRating(average))
+	peak := 0
+	for _, n := range counts {
+		peak = max(peak, n)
+	}
+	for i := len(counts) - 1; i >= 0; i-- {
+		line := fmt.Sprintf("  %2d  %4d", i+1, counts[i])
+		if counts[i] > 0 {
+			line += "  " + strings.Repeat("#", (counts[i]*barWidth+peak-1)/peak)
+		}
+		fmt.Println(line)
+	}
+}
+
+func formatRating(rating *float64) string {
+	if rating == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", *rating)
+}
+
+func formatRate(rate *float64) string {
+	if rate == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", *rate*100)
+}
@@ -0,0 +1,154 @@
+package stats
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+func handleGoalCommand() {
+	if len(os.Args) < 4 {
+		printGoalUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[3] {
+	case "list":
+		goalList()
+	case "add":
+		goalSave("POST")
+	case "update":
+		goalSave("PUT")
+	case "delete":
+		goalDelete()
+	default:
+		fmt.Printf("Unknown goal subcommand: %s\n", os.Args[3])
+		printGoalUsage()
+		os.Exit(1)
+	}
+}
+
+func printGoalUsage() {
+	fmt.Println("Usage: mangahub stats goal <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  list                                       List your goals and their progress")
+	fmt.Println("  add --metric=<m> --period=<p> --target=<n> [--genre=<genre>]")
+	fmt.Println("                                             Create a goal")
+	fmt.Println("  update <goal_id> [--metric=] [--period=] [--target=] [--genre=]")
+	fmt.Println("                                             Change a goal")
+	fmt.Println("  delete <goal_id>                           Delete a goal")
+	fmt.Println("\nMetrics: chapters, completed_series    Periods: week, month, year")
+}
+
+func goalList() {
+	cliConfig := api.MustLoadConfig(true)
+
+	goals := fetchGoals(cliConfig)
+	if len(goals) == 0 {
+		fmt.Println("You have no goals yet. Create one with 'mangahub stats goal add'.")
+		return
+	}
+
+	printGoals(goals)
+}
+
+// fetchGoals returns the user's goals with their current progress
+func fetchGoals(cliConfig *config.CLIConfig) []climodels.Goal {
+	var data climodels.GoalListResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/goals", nil, &data); err != nil {
+		fmt.Printf("❌ Failed to list goals: %v\n", err)
+		os.Exit(1)
+	}
+	return data.Items
+}
+
+func printGoals(goals []climodels.Goal) {
+	fmt.Println("Your Goals:")
+	for _, goal := range goals {
+		mark := " "
+		if goal.Achieved {
+			mark = "✓"
+		}
+		fmt.Printf("  [%s] %s: %d/%d (%.1f%%)\n", mark, describeGoal(goal), goal.Current, goal.Target, goal.Percent)
+		fmt.Printf("      ID: %s\n", goal.ID)
+	}
+}
+
+// describeGoal formats a goal such as "chapters this year" or "Action completed series this month"
+func describeGoal(goal climodels.Goal) string {
+	what := strings.ReplaceAll(goal.Metric, "_", " ")
+	if goal.Genre != "" {
+		what = goal.Genre + " " + what
+	}
+	return fmt.Sprintf("%s this %s", what, goal.Period)
+}
+
+// goalSave creates (POST) or updates (PUT) a goal from --flag arguments
+func goalSave(method string) {
+	args := os.Args[4:]
+	path := "/users/goals"
+	if method == "PUT" {
+		if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+			fmt.Println("Usage: mangahub stats goal update <goal_id> [--metric=] [--period=] [--target=] [--genre=]")
+			os.Exit(1)
+		}
+		path += "/" + url.PathEscape(args[0])
+		args = args[1:]
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	reqBody := climodels.GoalRequest{}
+	for _, arg := range args {
+		name, value, _ := strings.Cut(arg, "=")
+		switch name {
+		case "--metric":
+			reqBody.Metric = &value
+		case "--period":
+			reqBody.Period = &value
+		case "--genre":
+			reqBody.Genre = &value
+		case "--target":
+			target, err := strconv.Atoi(value)
+			if err != nil {
+				fmt.Printf("Error: Invalid target: %v\n", err)
+				os.Exit(1)
+			}
+			reqBody.Target = &target
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			printGoalUsage()
+			os.Exit(1)
+		}
+	}
+
+	var data climodels.GoalResponse
+	if _, err := api.Do(cliConfig, method, path, reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to save goal: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Goal saved: %s, %d/%d (ID: %s)\n", describeGoal(data.Goal), data.Goal.Current, data.Goal.Target, data.Goal.ID)
+}
+
+func goalDelete() {
+	if len(os.Args) < 5 {
+		fmt.Println("Usage: mangahub stats goal delete <goal_id>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", "/users/goals/"+url.PathEscape(os.Args[4]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to delete goal: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Goal deleted")
+}
@@ -21,6 +21,16 @@ func HandleStatsCommand() {
 	switch subcommand {
 	case "view":
 		viewStats()
+	case "goal":
+		handleGoalCommand()
+	case "compare":
+		compareStats()
+	case "achievements":
+		listAchievements()
+	case "timeline":
+		viewTimeline()
+	case "composition":
+		viewComposition()
 	default:
 		fmt.Printf("Unknown stats subcommand: %s\n", subcommand)
 		printStatsUsage()
@@ -31,7 +41,12 @@ func HandleStatsCommand() {
 func printStatsUsage() {
 	fmt.Println("Usage: mangahub stats <subcommand>")
 	fmt.Println("\nSubcommands:")
-	fmt.Println("  view                 View your reading statistics")
+	fmt.Println("  view                 View your reading statistics, recent activity, goals and badges")
+	fmt.Println("  timeline             Chapters read over time [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--bucket=day|week|month]")
+	fmt.Println("  composition          Your library by genre, author, publication status and rating [--top=<rows>]")
+	fmt.Println("  achievements         List every achievement and your progress toward it")
+	fmt.Println("  goal                 Manage reading goals (list, add, update, delete)")
+	fmt.Println("  compare <username>   Compare your reading taste with another user")
 }
 
 func viewStats() {
@@ -87,6 +102,10 @@ func viewStats() {
 
 		fmt.Printf("Total Chapters Read:     %v\n", apiResp.Data["total_chapters_read"])
 
+		fmt.Printf("Chapters Reread:         %v\n", apiResp.Data["reread_chapters"])
+
+		fmt.Printf("Rereads Started:         %v\n", apiResp.Data["rereads"])
+
 		fmt.Printf("Manga Reading:           %v\n", apiResp.Data["reading_manga"])
 
 		fmt.Printf("Manga Completed:         %v\n", apiResp.Data["completed_manga"])
@@ -97,6 +116,19 @@ func viewStats() {
 
 		fmt.Println("-------------------------------")
 
+		fmt.Println()
+		printRecentActivity(fetchTimeline(cliConfig, nil))
+
+		if goals := fetchGoals(cliConfig); len(goals) > 0 {
+			fmt.Println()
+			printGoals(goals)
+		}
+
+		if achievements := fetchAchievements(cliConfig); achievements.Unlocked > 0 {
+			fmt.Println()
+			printBadges(achievements)
+		}
+
 	} else {
 
 		var apiResp struct {
@@ -0,0 +1,110 @@
+package stats
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// sparkTicks are the glyphs of a sparkline, from the lowest value to the highest
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// barWidth is the length of the longest bar in a timeline listing
+const barWidth = 40
+
+func fetchTimeline(cliConfig *config.CLIConfig, query url.Values) climodels.ReadingTimeline {
+	path := "/users/stats/timeline"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var data climodels.ReadingTimeline
+	if _, err := api.Do(cliConfig, "GET", path, nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get reading timeline: %v\n", err)
+		os.Exit(1)
+	}
+	return data
+}
+
+// sparkline draws one glyph per point, scaled to the busiest point. Points
+// without reading are drawn as a dot so that gaps stand out.
+func sparkline(points []climodels.TimelinePoint) string {
+	peak := 0
+	for _, point := range points {
+		peak = max(peak, point.Chapters)
+	}
+
+	var line strings.Builder
+	for _, point := range points {
+		if point.Chapters == 0 {
+			line.WriteRune('·')
+			continue
+		}
+		tick := (point.Chapters*len(sparkTicks) - 1) / peak
+		line.WriteRune(sparkTicks[min(tick, len(sparkTicks)-1)])
+	}
+	return line.String()
+}
+
+// describeStreak formats reading streaks such as "3 days (longest 12)"
+func describeStreak(streak climodels.ReadingStreak) string {
+	return fmt.Sprintf("%s (longest %s)", pluralDays(streak.Current), pluralDays(streak.Longest))
+}
+
+func pluralDays(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+// printRecentActivity prints the last 30 days as a sparkline with the streaks
+func printRecentActivity(timeline climodels.ReadingTimeline) {
+	fmt.Printf("Last %d days:  %s  %d chapters\n", len(timeline.Points), sparkline(timeline.Points), timeline.TotalChapters)
+	fmt.Printf("Reading streak: %s\n", describeStreak(timeline.Streak))
+}
+
+func viewTimeline() {
+	cliConfig := api.MustLoadConfig(true)
+
+	query := url.Values{}
+	for _, arg := range os.Args[3:] {
+		name, value, ok := strings.Cut(arg, "=")
+		switch {
+		case ok && (name == "--from" || name == "--to" || name == "--bucket"):
+			query.Set(strings.TrimPrefix(name, "--"), value)
+		default:
+			fmt.Printf("Unknown option: %s\n", arg)
+			fmt.Println("Usage: mangahub stats timeline [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--bucket=day|week|month]")
+			os.Exit(1)
+		}
+	}
+
+	timeline := fetchTimeline(cliConfig, query)
+
+	fmt.Printf("--- Chapters read per %s, %s to %s ---\n", timeline.Bucket, timeline.From, timeline.To)
+	fmt.Printf("%s\n\n", sparkline(timeline.Points))
+
+	peak := 0
+	for _, point := range timeline.Points {
+		peak = max(peak, point.Chapters)
+	}
+	for _, point := range timeline.Points {
+		line := fmt.Sprintf("  %s  %5d", point.Start, point.Chapters)
+		if point.Chapters > 0 {
+			line += "  " + strings.Repeat("#", (point.Chapters*barWidth+peak-1)/peak)
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Printf("\nTotal: %d chapters on %s\n", timeline.TotalChapters, pluralDays(timeline.ActiveDays))
+	fmt.Printf("Reading streak: %s\n", describeStreak(timeline.Streak))
+	if timeline.Streak.LastReadOn != nil {
+		fmt.Printf("Last read on: %s\n", *timeline.Streak.LastReadOn)
+	}
+}
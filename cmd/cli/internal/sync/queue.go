@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
+)
+
+func syncStatus() {
+	cliConfig := api.MustLoadConfig(true)
+
+	store, err := offline.OpenConfigured(cliConfig)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
+	ops, err := store.Pending(cliConfig.User.ID)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	autoSync := "off"
+	if cliConfig.Sync.AutoSync {
+		autoSync = "on"
+	}
+	fmt.Printf("Conflict resolution: %s (auto sync %s)\n", offline.ResolutionStrategy(cliConfig), autoSync)
+
+	if len(ops) == 0 {
+		fmt.Println("✅ No pending operations. Everything is synced.")
+		return
+	}
+
+	fmt.Printf("\n📥 %d pending operation(s):\n", len(ops))
+	fmt.Printf("%-5s %-17s %-9s %-36s %s\n", "ID", "Queued", "Attempts", "Operation", "Manga")
+	fmt.Println("------------------------------------------------------------------------------------------")
+	for _, op := range ops {
+		fmt.Printf("%-5d %-17s %-9d %-36s %s\n",
+			op.ID, op.CreatedAt.Local().Format("2006-01-02 15:04"), op.Attempts, offline.Describe(op), op.MangaID)
+		if op.LastError != "" {
+			fmt.Printf("      last error: %s\n", op.LastError)
+		}
+	}
+	fmt.Println("\nRun 'mangahub sync push' to send them now.")
+}
+
+func syncPush() {
+	cliConfig := api.MustLoadConfig(true)
+
+	store, err := offline.OpenConfigured(cliConfig)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
+	outcomes, err := offline.Replay(cliConfig, store)
+	if len(outcomes) > 0 {
+		fmt.Printf("🔄 Synced %d queued operation(s):\n", len(outcomes))
+		offline.PrintOutcomes(outcomes)
+	}
+	if err != nil {
+		fmt.Printf("❌ Could not sync: %v\n", err)
+		os.Exit(1)
+	}
+	if len(outcomes) == 0 {
+		fmt.Println("✅ No pending operations. Everything is synced.")
+	}
+}
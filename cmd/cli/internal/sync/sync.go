@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -25,6 +26,10 @@ func HandleSyncCommand() {
 	switch subcommand {
 	case "monitor":
 		syncMonitor()
+	case "status":
+		syncStatus()
+	case "push":
+		syncPush()
 	default:
 		fmt.Printf("Unknown sync subcommand: %s\n", subcommand)
 		printSyncUsage()
@@ -36,6 +41,8 @@ func printSyncUsage() {
 	fmt.Println("Usage: mangahub sync <subcommand>")
 	fmt.Println("\nSubcommands:")
 	fmt.Println("  monitor              Monitor real-time progress updates (TCP)")
+	fmt.Println("  status               Show updates queued while offline")
+	fmt.Println("  push                 Send queued updates to the server now")
 }
 
 func syncMonitor() {
@@ -50,7 +57,7 @@ func syncMonitor() {
 		os.Exit(1)
 	}
 
-	addr := fmt.Sprintf("%s:%d", cliConfig.Server.Host, cliConfig.Server.TCPPort)
+	addr := net.JoinHostPort(cliConfig.Server.Host, strconv.Itoa(cliConfig.Server.TCPPort))
 	fmt.Printf("Connecting to TCP Sync Server at %s...\n", addr)
 
 	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
@@ -116,7 +123,7 @@ func syncMonitor() {
 				dataBytes, _ := json.Marshal(msg.Data)
 				json.Unmarshal(dataBytes, &b)
 
-				fmt.Printf("[%s] 📢 %s updated %s to Chapter %d (%s)\n", 
+				fmt.Printf("[%s] 📢 %s updated %s to Chapter %s (%s)\n", 
 					b.Timestamp.Format("15:04:05"), 
 					b.Username, b.MangaTitle, b.CurrentChapter, b.Status)
 			}
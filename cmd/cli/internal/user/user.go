@@ -0,0 +1,296 @@
+package user
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/api"
+	climodels "github.com/tnphucccc/mangahub/pkg/cli/models"
+)
+
+// statusOrder is the order reading statuses are listed in on a profile
+var statusOrder = []string{"reading", "completed", "plan_to_read", "on_hold", "dropped"}
+
+func HandleUserCommand() {
+	if len(os.Args) < 3 {
+		printUserUsage()
+		os.Exit(1)
+	}
+
+	subcommand := os.Args[2]
+
+	switch subcommand {
+	case "profile":
+		userProfile()
+	case "library":
+		userLibrary()
+	case "privacy":
+		userPrivacy()
+	case "follow":
+		userFollow(true)
+	case "unfollow":
+		userFollow(false)
+	case "followers":
+		userFollowList("followers")
+	case "following":
+		userFollowList("following")
+	case "remove-follower":
+		userRemoveFollower()
+	case "help":
+		printUserUsage()
+	default:
+		fmt.Printf("Unknown user subcommand: %s\n", subcommand)
+		printUserUsage()
+		os.Exit(1)
+	}
+}
+
+func printUserUsage() {
+	fmt.Println("Usage: mangahub user <subcommand> [options]")
+	fmt.Println("\nSubcommands:")
+	fmt.Println("  profile <username>                               Show a user's public profile")
+	fmt.Println("  library <username> [--status=<status>] [--shelf=<public shelf>] [--search=<title>] [--sort=<key>] [--limit=N] [--offset=N]")
+	fmt.Println("                                                   List the entries of a user's library you can see")
+	fmt.Println("  privacy [public|followers|private]               Show or change who can see your library")
+	fmt.Println("  follow <username>                                Follow a user to see their activity in 'mangahub feed'")
+	fmt.Println("  unfollow <username>                              Stop following a user")
+	fmt.Println("  followers [username] [--limit=N] [--offset=N]    List a user's followers (yours by default)")
+	fmt.Println("  following [username] [--limit=N] [--offset=N]    List who a user follows (you by default)")
+	fmt.Println("  remove-follower <username>                       Stop a user from following you")
+	fmt.Println("\nHide single entries with 'mangahub library hide <manga_id>'.")
+}
+
+func userProfile() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub user profile <username>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(false)
+
+	var data climodels.ProfileResponse
+	if _, err := api.Do(cliConfig, "GET", "/users/"+url.PathEscape(os.Args[3]), nil, &data); err != nil {
+		fmt.Printf("❌ Failed to get profile: %v\n", err)
+		os.Exit(1)
+	}
+
+	profile := data.Profile
+	fmt.Printf("%s\n", profile.Username)
+	fmt.Printf("  Joined: %s\n", profile.JoinedAt.Format("2006-01-02"))
+	fmt.Printf("  Visibility: %s\n", profile.Visibility)
+
+	if profile.Restricted || profile.Library == nil {
+		fmt.Println("  This library is not visible to you.")
+		return
+	}
+
+	fmt.Printf("  Library: %d entries, %d favorites\n", profile.Library.Total, profile.Library.Favorites)
+	for _, status := range statusOrder {
+		if count := profile.Library.ByStatus[status]; count > 0 {
+			fmt.Printf("    %-13s %d\n", status+":", count)
+		}
+	}
+
+	if len(profile.Achievements) > 0 {
+		fmt.Printf("  Achievements (%d):\n", len(profile.Achievements))
+		for _, achievement := range profile.Achievements {
+			fmt.Printf("    🏆 %s - %s\n", achievement.Name, achievement.Description)
+		}
+	}
+}
+
+func userLibrary() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub user library <username> [filters]")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(false)
+
+	username := os.Args[3]
+
+	// Map CLI flags to library query parameters
+	listFlags := map[string]string{
+		"--status=": "status",
+		"--shelf=":  "shelf",
+		"--genre=":  "genre",
+		"--search=": "q",
+		"--sort=":   "sort",
+		"--order=":  "order",
+		"--limit=":  "limit",
+		"--offset=": "offset",
+	}
+
+	queryParams := url.Values{}
+	for _, arg := range os.Args[4:] {
+		if arg == "--favorites" {
+			queryParams.Set("favorite", "true")
+			continue
+		}
+		for flag, param := range listFlags {
+			if strings.HasPrefix(arg, flag) {
+				queryParams.Set(param, strings.TrimPrefix(arg, flag))
+			}
+		}
+	}
+
+	var data climodels.LibraryListResponse
+	meta, err := api.Do(cliConfig, "GET", "/users/"+url.PathEscape(username)+"/library?"+queryParams.Encode(), nil, &data)
+	if err != nil {
+		fmt.Printf("❌ Failed to list library: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		fmt.Printf("No library entries of %s to show.\n", username)
+		return
+	}
+
+	if meta.TotalPages > 0 {
+		fmt.Printf("%s's Library (%d entries, page %d of %d):\n", username, meta.Total, meta.Page, meta.TotalPages)
+	} else {
+		fmt.Printf("%s's Library (%d entries):\n", username, meta.Total)
+	}
+	for _, item := range data.Items {
+		fmt.Printf("  Title: %s\n", item.Manga.Title)
+		fmt.Printf("  Status: %s\n", item.Status)
+		if item.Manga.TotalChapters > 0 {
+			fmt.Printf("  Current Chapter: %g/%d\n", item.CurrentChapter, item.Manga.TotalChapters)
+		} else {
+			fmt.Printf("  Current Chapter: %g\n", item.CurrentChapter)
+		}
+		if item.Rating != nil && *item.Rating > 0 {
+			fmt.Printf("  Rating: %d/10\n", *item.Rating)
+		}
+		if len(item.Shelves) > 0 {
+			fmt.Printf("  Shelves: %s\n", strings.Join(item.Shelves, ", "))
+		}
+		fmt.Printf("  --------------------\n")
+	}
+}
+
+func userPrivacy() {
+	cliConfig := api.MustLoadConfig(true)
+
+	if len(os.Args) < 4 {
+		var data climodels.UserResponse
+		if _, err := api.Do(cliConfig, "GET", "/users/me", nil, &data); err != nil {
+			fmt.Printf("❌ Failed to get privacy settings: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Your library is visible to: %s\n", describeVisibility(data.User.Visibility))
+		return
+	}
+
+	reqBody := climodels.PrivacyUpdateRequest{Visibility: os.Args[3]}
+
+	var data climodels.UserResponse
+	if _, err := api.Do(cliConfig, "PUT", "/users/me/privacy", reqBody, &data); err != nil {
+		fmt.Printf("❌ Failed to update privacy settings: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ Your library is now visible to: %s\n", describeVisibility(data.User.Visibility))
+}
+
+// describeVisibility explains a visibility setting in plain words
+func describeVisibility(visibility string) string {
+	switch visibility {
+	case "followers":
+		return "your followers"
+	case "private":
+		return "only you"
+	default:
+		return "everyone"
+	}
+}
+
+func userFollow(follow bool) {
+	if len(os.Args) < 4 {
+		fmt.Printf("Usage: mangahub user %s <username>\n", os.Args[2])
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	username := os.Args[3]
+	method := "POST"
+	if !follow {
+		method = "DELETE"
+	}
+
+	if _, err := api.Do(cliConfig, method, "/users/"+url.PathEscape(username)+"/follow", nil, nil); err != nil {
+		fmt.Printf("❌ Failed to %s %s: %v\n", os.Args[2], username, err)
+		os.Exit(1)
+	}
+
+	if follow {
+		fmt.Printf("✅ You are now following %s\n", username)
+	} else {
+		fmt.Printf("✅ You are no longer following %s\n", username)
+	}
+}
+
+func userFollowList(list string) {
+	cliConfig := api.MustLoadConfig(false)
+
+	username := cliConfig.User.Username
+	params := url.Values{}
+	for _, arg := range os.Args[3:] {
+		switch {
+		case strings.HasPrefix(arg, "--limit="):
+			params.Set("limit", strings.TrimPrefix(arg, "--limit="))
+		case strings.HasPrefix(arg, "--offset="):
+			params.Set("offset", strings.TrimPrefix(arg, "--offset="))
+		default:
+			username = arg
+		}
+	}
+	if username == "" {
+		fmt.Printf("Usage: mangahub user %s <username>\n", list)
+		os.Exit(1)
+	}
+
+	var data climodels.FollowListResponse
+	meta, err := api.Do(cliConfig, "GET", "/users/"+url.PathEscape(username)+"/"+list+"?"+params.Encode(), nil, &data)
+	if err != nil {
+		fmt.Printf("❌ Failed to list %s: %v\n", list, err)
+		os.Exit(1)
+	}
+
+	if len(data.Items) == 0 {
+		if list == "followers" {
+			fmt.Printf("%s has no followers yet.\n", username)
+		} else {
+			fmt.Printf("%s is not following anyone yet.\n", username)
+		}
+		return
+	}
+
+	if list == "followers" {
+		fmt.Printf("Followers of %s (%d):\n", username, meta.Total)
+	} else {
+		fmt.Printf("Followed by %s (%d):\n", username, meta.Total)
+	}
+	for _, user := range data.Items {
+		fmt.Printf("  %-24s since %s\n", user.Username, user.FollowedAt.Format("2006-01-02"))
+	}
+}
+
+func userRemoveFollower() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: mangahub user remove-follower <username>")
+		os.Exit(1)
+	}
+
+	cliConfig := api.MustLoadConfig(true)
+
+	if _, err := api.Do(cliConfig, "DELETE", "/users/me/followers/"+url.PathEscape(os.Args[3]), nil, nil); err != nil {
+		fmt.Printf("❌ Failed to remove follower: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ %s no longer follows you\n", os.Args[3])
+}
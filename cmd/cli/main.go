@@ -6,15 +6,21 @@ import (
 
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/auth"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/chat"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/club"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/comments"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/config"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/feed"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/grpc_client"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/library"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/manga"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/notify"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/offline"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/progress"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/review"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/server"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/stats"
 	"github.com/tnphucccc/mangahub/cmd/cli/internal/sync"
+	"github.com/tnphucccc/mangahub/cmd/cli/internal/user"
 )
 
 const version = "1.0.0-dev"
@@ -27,6 +33,12 @@ func main() {
 
 	command := os.Args[1]
 
+	// Send anything queued while offline before talking to the API again
+	switch command {
+	case "manga", "library", "progress", "review", "comments", "stats", "user", "feed", "club":
+		offline.AutoSync()
+	}
+
 	switch command {
 	case "version":
 		fmt.Printf("MangaHub CLI v%s\n", version)
@@ -44,6 +56,16 @@ func main() {
 		library.HandleLibraryCommand()
 	case "progress":
 		progress.HandleProgressCommand()
+	case "review":
+		review.HandleReviewCommand()
+	case "comments":
+		comments.HandleCommentsCommand()
+	case "user":
+		user.HandleUserCommand()
+	case "feed":
+		feed.HandleFeedCommand()
+	case "club":
+		club.HandleClubCommand()
 	case "chat":
 		chat.HandleChatCommand()
 	case "stats":
@@ -72,11 +94,16 @@ func printUsage() {
 	fmt.Println("  server               Manage servers (start, stop, status)")
 	fmt.Println("  auth                 Authentication (register, login, logout, status)")
 	fmt.Println("  manga                Manga operations (search, info, list)")
-	fmt.Println("  library              Library management (add, remove, list)")
-	fmt.Println("  progress             Progress tracking (update, history)")
+	fmt.Println("  library              Library management (add, list, next, queue, hide, shelf, ...)")
+	fmt.Println("  progress             Progress tracking (update, reread, reads)")
+	fmt.Println("  review               Manga reviews (write, list, mine, delete, helpful)")
+	fmt.Println("  comments             Chapter discussions (list, show, post, reply, edit, delete)")
+	fmt.Println("  user                 Profiles, privacy and follows (profile, library, privacy, follow, ...)")
+	fmt.Println("  feed                 Reading activity of the users you follow")
+	fmt.Println("  club                 Reading clubs with shared schedules (create, progress, remind, ...)")
 	fmt.Println("  chat                 Chat system (join, send)")
-	fmt.Println("  stats                User statistics (view)")
-	fmt.Println("  sync                 Real-time synchronization (TCP monitor)")
+	fmt.Println("  stats                Statistics, timelines, library composition, goals, achievements and taste comparison (view, timeline, composition, goal, achievements, compare)")
+	fmt.Println("  sync                 Synchronization (monitor, status, push)")
 	fmt.Println("  notify               Notifications (UDP listener)")
 	fmt.Println("  grpc                 Internal service queries (gRPC)")
 	fmt.Println("\nFor more information on a command:")
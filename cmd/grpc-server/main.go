@@ -9,9 +9,18 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/tnphucccc/mangahub/internal/achievement"
+	"github.com/tnphucccc/mangahub/internal/activity"
+	"github.com/tnphucccc/mangahub/internal/auth"
+	"github.com/tnphucccc/mangahub/internal/follow"
+	"github.com/tnphucccc/mangahub/internal/goal"
 	grpchandler "github.com/tnphucccc/mangahub/internal/grpc"
 	"github.com/tnphucccc/mangahub/internal/grpc/pb"
 	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/tcp"
+	"github.com/tnphucccc/mangahub/internal/udp"
 	"github.com/tnphucccc/mangahub/internal/user"
 	"github.com/tnphucccc/mangahub/pkg/config"
 	"github.com/tnphucccc/mangahub/pkg/database"
@@ -40,12 +49,55 @@ func main() {
 	defer database.Close(db)
 
 	// Dependency Injection
+	jwtManager := auth.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpiryDays)
 	mangaRepo := manga.NewRepository(db)
 	userRepo := user.NewRepository(db)
+	userService := user.NewService(userRepo, jwtManager)
 	mangaService := manga.NewService(mangaRepo, userRepo)
 
+	// Progress updates over gRPC show up in followers' feeds and reading timelines too
+	activityService := activity.NewService(activity.NewRepository(db))
+	mangaService.OnProgressChange(activityService.RecordProgressChange)
+	statsService := stats.NewService(stats.NewRepository(db))
+	mangaService.OnProgressChange(statsService.RecordProgressChange)
+
+	// ...move reading goals, whose notifications go out through the UDP server...
+	goalService := goal.NewService(goal.NewRepository(db), mangaService)
+	mangaService.OnProgressUpdate(goalService.CheckGoals)
+
+	// ...and unlock achievements. There is no chat hub in this process, so
+	// achievements unlocked here are not announced.
+	achievementService := achievement.NewService(achievement.NewRepository(db), userService)
+	mangaService.OnProgressUpdate(achievementService.CheckAchievements)
+
+	// Library statistics follow the owner's profile privacy settings
+	profileService := profile.NewService(userService, mangaService)
+	followService := follow.NewService(follow.NewRepository(db), userService, mangaService, profileService)
+	profileService.UseFollowerCheck(followService.IsFollowing)
+
+	// Forward progress broadcasts and notifications to the TCP and UDP servers,
+	// as the API server does for its own updates
+	tcpAddr := net.JoinHostPort(utils.GetEnv("TCP_HOST", cfg.Server.Host), cfg.Server.TCPPort)
+	udpAddr := net.JoinHostPort(utils.GetEnv("UDP_HOST", cfg.Server.Host), cfg.Server.UDPPort)
+	go func() {
+		for {
+			select {
+			case progress := <-mangaService.TCPBroadcastChan:
+				if err := tcp.NotifyProgress(tcpAddr, jwtManager, progress); err != nil {
+					log.Printf("Failed to notify TCP server about progress: %v", err)
+				}
+			case notification := <-mangaService.UDPNotificationChan:
+				if err := udp.Notify(udpAddr, notification); err != nil {
+					log.Printf("Failed to notify UDP server: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	// Initialize gRPC Server
-	grpcService := grpchandler.NewServer(mangaService)
+	grpcService := grpchandler.NewServer(mangaService, statsService, userService, profileService)
 
 	// Start gRPC listener
 	addr := fmt.Sprintf(":%s", cfg.Server.GRPCPort)
@@ -7,8 +7,13 @@ import (
 	"syscall"
 
 	"github.com/tnphucccc/mangahub/internal/auth"
+	"github.com/tnphucccc/mangahub/internal/follow"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
 	"github.com/tnphucccc/mangahub/internal/tcp"
+	"github.com/tnphucccc/mangahub/internal/user"
 	"github.com/tnphucccc/mangahub/pkg/config"
+	"github.com/tnphucccc/mangahub/pkg/database"
 	"github.com/tnphucccc/mangahub/pkg/utils"
 )
 
@@ -26,8 +31,25 @@ func main() {
 	// Initialize JWT manager for authentication
 	jwtManager := auth.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpiryDays)
 
+	// Connect to database (privacy settings and follows decide who receives broadcasts)
+	dbConfig := database.DefaultConfig()
+	dbConfig.Path = cfg.Database.Path
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.Close(db)
+
+	userRepo := user.NewRepository(db)
+	userService := user.NewService(userRepo, jwtManager)
+	mangaService := manga.NewService(manga.NewRepository(db), userRepo)
+	profileService := profile.NewService(userService, mangaService)
+	followService := follow.NewService(follow.NewRepository(db), userService, mangaService, profileService)
+	profileService.UseFollowerCheck(followService.IsFollowing)
+
 	// Create TCP server
 	server := tcp.NewServer(cfg.Server.TCPPort, jwtManager)
+	server.UseAudienceResolver(followService.BroadcastAudience)
 
 	// Start server
 	if err := server.Start(); err != nil {
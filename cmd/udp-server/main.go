@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/tnphucccc/mangahub/internal/auth"
 	"github.com/tnphucccc/mangahub/internal/udp"
 	"github.com/tnphucccc/mangahub/pkg/config"
 	"github.com/tnphucccc/mangahub/pkg/utils"
@@ -22,8 +23,11 @@ func main() {
 	log.Println("Starting UDP Notification Server...")
 	log.Printf("Configuration loaded from: %s", configPath)
 
+	// Initialize JWT manager for authenticating registrations
+	jwtManager := auth.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpiryDays)
+
 	// Create UDP notification server
-	server := udp.NewServer(cfg.Server.UDPPort)
+	server := udp.NewServer(cfg.Server.UDPPort, jwtManager)
 
 	// Start server
 	if err := server.Start(); err != nil {
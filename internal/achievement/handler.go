@@ -0,0 +1,48 @@
+package achievement
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles achievement HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new achievement handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// List returns every achievement with the authenticated user's progress toward it
+// GET /users/achievements
+func (h *Handler) List(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+	user := userInterface.(*models.User)
+
+	achievements, err := h.service.List(user.ID)
+	if err != nil {
+		response.InternalError(c, "Failed to list achievements")
+		return
+	}
+
+	unlocked := 0
+	for _, achievement := range achievements {
+		if achievement.Unlocked {
+			unlocked++
+		}
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": achievements, "unlocked": unlocked}, &response.Meta{
+		Total: len(achievements),
+		Count: len(achievements),
+	})
+}
@@ -0,0 +1,152 @@
+package achievement
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles achievement data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new achievement repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Unlocked returns when the user unlocked each of their achievements, by achievement ID
+func (r *Repository) Unlocked(userID string) (map[string]time.Time, error) {
+	rows, err := r.db.Query(`SELECT achievement_id, unlocked_at FROM user_achievements WHERE user_id = ?`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+	defer rows.Close()
+
+	unlocked := map[string]time.Time{}
+	for rows.Next() {
+		var id string
+		var at time.Time
+		if err := rows.Scan(&id, &at); err != nil {
+			return nil, fmt.Errorf("failed to scan achievement: %w", err)
+		}
+		unlocked[id] = at
+	}
+
+	return unlocked, rows.Err()
+}
+
+// Unlock records that the user unlocked an achievement. It returns false when
+// the achievement was already unlocked.
+func (r *Repository) Unlock(userID, achievementID string, at time.Time) (bool, error) {
+	result, err := r.db.Exec(`
+		INSERT OR IGNORE INTO user_achievements (user_id, achievement_id, unlocked_at)
+		VALUES (?, ?, ?)
+	`, userID, achievementID, at.UTC())
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock achievement: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// Metrics measures everything achievement rules look at for the user.
+// weekStart is where the 7 days counted by weekly_chapters begin.
+func (r *Repository) Metrics(userID string, weekStart time.Time) (models.AchievementMetrics, error) {
+	metrics := models.AchievementMetrics{}
+
+	// Series finished now or on an earlier, archived pass
+	var completed int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM (
+			SELECT manga_id FROM user_progress WHERE user_id = ? AND status = 'completed'
+			UNION
+			SELECT manga_id FROM read_throughs WHERE user_id = ?
+		)
+	`, userID, userID).Scan(&completed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count completed series: %w", err)
+	}
+	metrics[models.AchievementMetricCompletedSeries] = completed
+
+	// Chapters are counted once per series, as in the reading statistics
+	var chapters, rated int
+	err = r.db.QueryRow(`
+		SELECT
+			COALESCE(SUM(MAX(CAST(up.current_chapter AS INTEGER), COALESCE(rt.max_chapter, 0))), 0),
+			COUNT(up.rating)
+		FROM user_progress up
+		LEFT JOIN (
+			SELECT manga_id, MAX(CAST(chapter AS INTEGER)) as max_chapter
+			FROM read_throughs
+			WHERE user_id = ?
+			GROUP BY manga_id
+		) rt ON rt.manga_id = up.manga_id
+		WHERE up.user_id = ?
+	`, userID, userID).Scan(&chapters, &rated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count chapters: %w", err)
+	}
+	metrics[models.AchievementMetricChaptersRead] = chapters
+	metrics[models.AchievementMetricRatedSeries] = rated
+
+	// Only whole chapters count, as for reading goals
+	var weekly int
+	err = r.db.QueryRow(`
+		SELECT COALESCE(SUM(MAX(CAST(to_chapter AS INTEGER) - CAST(from_chapter AS INTEGER), 0)), 0)
+		FROM progress_history
+		WHERE user_id = ? AND created_at >= ?
+	`, userID, weekStart.UTC().Format("2006-01-02 15:04:05")).Scan(&weekly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count weekly chapters: %w", err)
+	}
+	metrics[models.AchievementMetricWeeklyChapters] = weekly
+
+	genres, err := r.countGenres(userID)
+	if err != nil {
+		return nil, err
+	}
+	metrics[models.AchievementMetricGenresRead] = genres
+
+	return metrics, nil
+}
+
+// countGenres counts the distinct genres of the series the user has started
+func (r *Repository) countGenres(userID string) (int, error) {
+	rows, err := r.db.Query(`
+		SELECT m.genres
+		FROM user_progress up
+		JOIN manga m ON m.id = up.manga_id
+		WHERE up.user_id = ? AND up.status != 'plan_to_read'
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list genres: %w", err)
+	}
+	defer rows.Close()
+
+	genres := map[string]bool{}
+	for rows.Next() {
+		var genresJSON string
+		if err := rows.Scan(&genresJSON); err != nil {
+			return 0, fmt.Errorf("failed to scan genres: %w", err)
+		}
+		var manga models.Manga
+		if err := manga.UnmarshalGenres(genresJSON); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal genres: %w", err)
+		}
+		for _, genre := range manga.Genres {
+			genres[strings.ToLower(strings.TrimSpace(genre))] = true
+		}
+	}
+	delete(genres, "")
+
+	return len(genres), rows.Err()
+}
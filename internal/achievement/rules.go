@@ -0,0 +1,55 @@
+package achievement
+
+import (
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Catalog lists every achievement, in the order they are shown. IDs are stored
+// with unlocked achievements, so they must never change.
+var Catalog = []models.Achievement{
+	{ID: "first_completion", Name: "First Finish", Description: "Complete your first series", Metric: models.AchievementMetricCompletedSeries, Target: 1},
+	{ID: "ten_completions", Name: "Shelf Clearer", Description: "Complete 10 series", Metric: models.AchievementMetricCompletedSeries, Target: 10},
+	{ID: "hundred_chapters", Name: "Getting Hooked", Description: "Read 100 chapters", Metric: models.AchievementMetricChaptersRead, Target: 100},
+	{ID: "thousand_chapters", Name: "Thousand Chapters", Description: "Read 1,000 chapters", Metric: models.AchievementMetricChaptersRead, Target: 1000},
+	{ID: "binge_week", Name: "Binge Week", Description: "Read 100 chapters in a week", Metric: models.AchievementMetricWeeklyChapters, Target: 100},
+	{ID: "five_genres", Name: "Genre Explorer", Description: "Read series from 5 different genres", Metric: models.AchievementMetricGenresRead, Target: 5},
+	{ID: "ten_ratings", Name: "Critic", Description: "Rate 10 series", Metric: models.AchievementMetricRatedSeries, Target: 10},
+}
+
+// weekLength is the window the weekly_chapters metric counts, ending now
+const weekLength = 7 * 24 * time.Hour
+
+// Evaluate returns the achievements of the catalog that metrics reach and that
+// are not unlocked yet
+func Evaluate(metrics models.AchievementMetrics, unlocked map[string]time.Time) []models.Achievement {
+	reached := []models.Achievement{}
+	for _, achievement := range Catalog {
+		if _, ok := unlocked[achievement.ID]; ok {
+			continue
+		}
+		if metrics[achievement.Metric] >= achievement.Target {
+			reached = append(reached, achievement)
+		}
+	}
+	return reached
+}
+
+// Progress reports every achievement of the catalog against metrics
+func Progress(metrics models.AchievementMetrics, unlocked map[string]time.Time) []models.AchievementProgress {
+	progress := make([]models.AchievementProgress, 0, len(Catalog))
+	for _, achievement := range Catalog {
+		p := models.AchievementProgress{
+			Achievement: achievement,
+			Current:     min(metrics[achievement.Metric], achievement.Target),
+		}
+		if at, ok := unlocked[achievement.ID]; ok {
+			p.Unlocked = true
+			p.UnlockedAt = &at
+			p.Current = achievement.Target
+		}
+		progress = append(progress, p)
+	}
+	return progress
+}
@@ -0,0 +1,129 @@
+package achievement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/internal/websocket"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// announcementRoom is where unlocks of users with public profiles are announced
+const announcementRoom = "general"
+
+// Announcer delivers system messages about a user's achievements to chat clients
+type Announcer interface {
+	Announce(announcement websocket.Announcement)
+}
+
+// Service handles achievement business logic
+type Service struct {
+	repo        *Repository
+	userService *user.Service
+	announcer   Announcer
+}
+
+// NewService creates a new achievement service
+func NewService(repo *Repository, userService *user.Service) *Service {
+	return &Service{repo: repo, userService: userService}
+}
+
+// UseAnnouncer sets where unlocks are announced. Without one, achievements
+// are unlocked silently.
+func (s *Service) UseAnnouncer(announcer Announcer) {
+	s.announcer = announcer
+}
+
+// List returns every achievement with the user's progress toward it. Achievements
+// reached before they were checked, such as by reading done before they existed,
+// are unlocked first.
+func (s *Service) List(userID string) ([]models.AchievementProgress, error) {
+	unlocked, err := s.repo.Unlocked(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	metrics, err := s.repo.Metrics(userID, now.Add(-weekLength))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.unlockReached(userID, metrics, unlocked, now); err != nil {
+		return nil, err
+	}
+
+	return Progress(metrics, unlocked), nil
+}
+
+// Unlocked returns the achievements the user has unlocked, in catalog order
+func (s *Service) Unlocked(userID string) ([]models.UnlockedAchievement, error) {
+	unlocked, err := s.repo.Unlocked(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	achievements := []models.UnlockedAchievement{}
+	for _, achievement := range Catalog {
+		if at, ok := unlocked[achievement.ID]; ok {
+			achievements = append(achievements, models.UnlockedAchievement{Achievement: achievement, UnlockedAt: at})
+		}
+	}
+	return achievements, nil
+}
+
+// CheckAchievements unlocks every achievement the user has newly reached and
+// announces it
+func (s *Service) CheckAchievements(userID string) error {
+	unlocked, err := s.repo.Unlocked(userID)
+	if err != nil {
+		return err
+	}
+	if len(unlocked) == len(Catalog) {
+		return nil
+	}
+
+	now := time.Now()
+	metrics, err := s.repo.Metrics(userID, now.Add(-weekLength))
+	if err != nil {
+		return err
+	}
+
+	return s.unlockReached(userID, metrics, unlocked, now)
+}
+
+// unlockReached unlocks and announces the achievements metrics reach, adding
+// them to unlocked
+func (s *Service) unlockReached(userID string, metrics models.AchievementMetrics, unlocked map[string]time.Time, now time.Time) error {
+	for _, achievement := range Evaluate(metrics, unlocked) {
+		added, err := s.repo.Unlock(userID, achievement.ID, now)
+		if err != nil {
+			return err
+		}
+		if added {
+			unlocked[achievement.ID] = now
+			s.announce(userID, achievement)
+		}
+	}
+	return nil
+}
+
+// announce tells the user about an unlock, and everyone in the announcement
+// room too when the user's profile is public
+func (s *Service) announce(userID string, achievement models.Achievement) {
+	if s.announcer == nil {
+		return
+	}
+
+	announcement := websocket.Announcement{
+		UserID:   userID,
+		Room:     announcementRoom,
+		Personal: fmt.Sprintf("Achievement unlocked: %s (%s)", achievement.Name, achievement.Description),
+	}
+	if u, err := s.userService.GetByID(userID); err == nil && u.Visibility == models.ProfileVisibilityPublic {
+		announcement.Public = fmt.Sprintf("%s unlocked the achievement %s (%s)", u.Username, achievement.Name, achievement.Description)
+	}
+
+	s.announcer.Announce(announcement)
+}
@@ -0,0 +1,42 @@
+package activity
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles activity feed HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new activity handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Feed returns recent activity of the users the current user follows
+// GET /users/feed?limit=20&offset=0
+func (h *Handler) Feed(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+	user := userInterface.(*models.User)
+
+	var query models.FeedQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	events, total, err := h.service.Feed(user.ID, query)
+	if err != nil {
+		response.InternalError(c, "Failed to get activity feed")
+		return
+	}
+
+	response.Paginated(c, events, total, feedLimit(query), query.Offset)
+}
@@ -0,0 +1,102 @@
+package activity
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles activity event data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new activity repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Insert records an activity event
+func (r *Repository) Insert(event *models.ActivityEvent) error {
+	_, err := r.db.Exec(`
+		INSERT INTO activity_events (user_id, manga_id, event_type, chapter, rating, review_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, event.UserID, event.MangaID, event.Type, event.Chapter, event.Rating, event.ReviewID)
+	if err != nil {
+		return fmt.Errorf("failed to record activity: %w", err)
+	}
+	return nil
+}
+
+// feedConditions select the events of users followed by the viewer that the
+// viewer may see: nothing from private users or hidden library entries, and no
+// reviews that have since been deleted. Followers-only users are visible since
+// the viewer follows them.
+const feedConditions = `
+	FROM activity_events e
+	JOIN follows f ON f.followee_id = e.user_id AND f.follower_id = ?
+	JOIN users u ON u.id = e.user_id
+	JOIN manga m ON m.id = e.manga_id
+	LEFT JOIN user_progress up ON up.user_id = e.user_id AND up.manga_id = e.manga_id
+	WHERE u.visibility != 'private'
+	  AND COALESCE(up.hidden, 0) = 0
+	  AND (e.event_type != 'reviewed' OR EXISTS (SELECT 1 FROM reviews r WHERE r.id = e.review_id))
+`
+
+// Feed returns the events of users the viewer follows, newest first, with the total count
+func (r *Repository) Feed(viewerID string, limit, offset int) ([]models.ActivityEvent, int, error) {
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) `+feedConditions, viewerID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count feed: %w", err)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT e.id, e.user_id, u.username, e.event_type, e.manga_id, m.title,
+		       e.chapter, e.rating, e.review_id, e.created_at
+		`+feedConditions+`
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT ? OFFSET ?
+	`, viewerID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read feed: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.ActivityEvent{}
+	for rows.Next() {
+		var event models.ActivityEvent
+		var chapter sql.NullFloat64
+		var rating sql.NullInt64
+		var reviewID sql.NullString
+		err := rows.Scan(
+			&event.ID,
+			&event.UserID,
+			&event.Username,
+			&event.Type,
+			&event.MangaID,
+			&event.MangaTitle,
+			&chapter,
+			&rating,
+			&reviewID,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan activity event: %w", err)
+		}
+		if chapter.Valid {
+			c := models.ChapterNumber(chapter.Float64)
+			event.Chapter = &c
+		}
+		if rating.Valid {
+			v := int(rating.Int64)
+			event.Rating = &v
+		}
+		if reviewID.Valid {
+			event.ReviewID = &reviewID.String
+		}
+		events = append(events, event)
+	}
+
+	return events, total, rows.Err()
+}
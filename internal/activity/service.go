@@ -0,0 +1,89 @@
+package activity
+
+import (
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const defaultFeedLimit = 20
+
+// Service handles activity recording and feed business logic
+type Service struct {
+	repo *Repository
+}
+
+// NewService creates a new activity service
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// RecordProgressChange records the events of a progress update. It is meant to
+// be registered with the manga service's OnProgressChange.
+func (s *Service) RecordProgressChange(before, after models.UserProgress) error {
+	for _, event := range ProgressEvents(before, after) {
+		if err := s.repo.Insert(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordReview records that a review was written. It is meant to be registered
+// with the review service's OnReviewCreated.
+func (s *Service) RecordReview(review *models.Review) error {
+	return s.repo.Insert(&models.ActivityEvent{
+		UserID:   review.UserID,
+		Type:     models.ActivityReviewed,
+		MangaID:  review.MangaID,
+		ReviewID: &review.ID,
+	})
+}
+
+// Feed returns the recent activity of the users the viewer follows
+func (s *Service) Feed(viewerID string, query models.FeedQuery) ([]models.ActivityEvent, int, error) {
+	return s.repo.Feed(viewerID, feedLimit(query), query.Offset)
+}
+
+// ProgressEvents turns a progress update into activity events:
+//   - completed when the entry moves to completed, otherwise progress when the
+//     chapter moves forward (going back, e.g. for a reread, is not announced)
+//   - rated when the rating is set or changed
+func ProgressEvents(before, after models.UserProgress) []models.ActivityEvent {
+	var events []models.ActivityEvent
+
+	chapter := after.CurrentChapter
+	switch {
+	case after.Status == models.ReadingStatusCompleted && before.Status != models.ReadingStatusCompleted:
+		events = append(events, models.ActivityEvent{
+			UserID:  after.UserID,
+			Type:    models.ActivityCompleted,
+			MangaID: after.MangaID,
+			Chapter: &chapter,
+		})
+	case after.CurrentChapter > before.CurrentChapter:
+		events = append(events, models.ActivityEvent{
+			UserID:  after.UserID,
+			Type:    models.ActivityProgress,
+			MangaID: after.MangaID,
+			Chapter: &chapter,
+		})
+	}
+
+	if after.Rating != nil && (before.Rating == nil || *before.Rating != *after.Rating) {
+		rating := *after.Rating
+		events = append(events, models.ActivityEvent{
+			UserID:  after.UserID,
+			Type:    models.ActivityRated,
+			MangaID: after.MangaID,
+			Rating:  &rating,
+		})
+	}
+
+	return events
+}
+
+func feedLimit(query models.FeedQuery) int {
+	if query.Limit <= 0 {
+		return defaultFeedLimit
+	}
+	return query.Limit
+}
@@ -12,9 +12,13 @@ import (
 type Claims struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
+	Service  bool   `json:"service,omitempty"` // Issued to a MangaHub server rather than a user
 	jwt.RegisteredClaims
 }
 
+// serviceTokenExpiry keeps service tokens short-lived; they are minted per use
+const serviceTokenExpiry = 5 * time.Minute
+
 // JWTManager handles JWT token generation and validation
 type JWTManager struct {
 	secretKey  string
@@ -54,6 +58,28 @@ func (m *JWTManager) GenerateToken(user *models.User) (string, error) {
 	return tokenString, nil
 }
 
+// GenerateServiceToken generates a short-lived token that lets another MangaHub
+// server (named by name) act on behalf of users, e.g. to bridge progress updates
+func (m *JWTManager) GenerateServiceToken(name string) (string, error) {
+	claims := Claims{
+		Username: name,
+		Service:  true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(serviceTokenExpiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(m.secretKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign service token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
 // ValidateToken validates a JWT token and returns the claims
 func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 	// Parse token
@@ -85,6 +111,9 @@ func (m *JWTManager) RefreshToken(tokenString string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if claims.Service {
+		return "", fmt.Errorf("service tokens cannot be refreshed")
+	}
 
 	// Create new claims with extended expiry
 	newClaims := Claims{
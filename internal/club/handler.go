@@ -0,0 +1,226 @@
+package club
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles reading club HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new reading club handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "club not found":
+		response.NotFound(c, "Club not found")
+	case err.Error() == "user not found":
+		response.NotFound(c, "User not found")
+	case err.Error() == "manga not found":
+		response.NotFound(c, "Manga not found")
+	case err.Error() == "not a member":
+		response.NotFound(c, "User is not a member of this club")
+	case err.Error() == "not a club member":
+		response.Forbidden(c, "Only members can see this club")
+	case err.Error() == "not club owner":
+		response.Forbidden(c, "Only the club owner can do this")
+	case err.Error() == "already a member":
+		response.Conflict(c, "User is already a member of this club")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// Create creates a club owned by the caller
+// POST /clubs
+func (h *Handler) Create(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ClubCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	details, err := h.service.Create(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to create club")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, details)
+}
+
+// List returns the caller's clubs
+// GET /clubs
+func (h *Handler) List(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	clubs, err := h.service.List(user.ID)
+	if err != nil {
+		respondError(c, err, "Failed to list clubs")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": clubs}, &response.Meta{
+		Total: len(clubs),
+		Count: len(clubs),
+	})
+}
+
+// Get returns a club and its members
+// GET /clubs/:club_id
+func (h *Handler) Get(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	details, err := h.service.Get(user.ID, c.Param("club_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get club")
+		return
+	}
+
+	response.Success(c, http.StatusOK, details)
+}
+
+// Update changes a club's details and schedule
+// PUT /clubs/:club_id
+func (h *Handler) Update(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ClubUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	details, err := h.service.Update(user.ID, c.Param("club_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to update club")
+		return
+	}
+
+	response.Success(c, http.StatusOK, details)
+}
+
+// Delete removes a club
+// DELETE /clubs/:club_id
+func (h *Handler) Delete(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(user.ID, c.Param("club_id")); err != nil {
+		respondError(c, err, "Failed to delete club")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Club deleted"})
+}
+
+// AddMember adds a user to a club
+// POST /clubs/:club_id/members
+func (h *Handler) AddMember(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ClubMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	details, err := h.service.AddMember(user.ID, c.Param("club_id"), req.Username)
+	if err != nil {
+		respondError(c, err, "Failed to add club member")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, details)
+}
+
+// RemoveMember removes a member from a club, or lets a member leave
+// DELETE /clubs/:club_id/members/:username
+func (h *Handler) RemoveMember(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(user.ID, c.Param("club_id"), c.Param("username")); err != nil {
+		respondError(c, err, "Failed to remove club member")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Member removed"})
+}
+
+// Progress compares the members' progress with the club's schedule
+// GET /clubs/:club_id/progress
+func (h *Handler) Progress(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	progress, err := h.service.Progress(user, c.Param("club_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get club progress")
+		return
+	}
+
+	response.Success(c, http.StatusOK, progress)
+}
+
+// Remind sends this week's target to members who have not reached it
+// POST /clubs/:club_id/remind
+func (h *Handler) Remind(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	sent, err := h.service.Remind(user.ID, c.Param("club_id"))
+	if err != nil {
+		respondError(c, err, "Failed to send reminders")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"reminded": sent})
+}
@@ -0,0 +1,271 @@
+package club
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles reading club data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new reading club repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// memberEntry is a club member with their progress in the club's manga
+type memberEntry struct {
+	UserID     string
+	Username   string
+	Visibility models.ProfileVisibility
+	Chapter    *models.ChapterNumber // nil when the manga is not in their library
+	Hidden     bool
+}
+
+const clubSelect = `
+	SELECT c.id, c.name, c.description, c.owner_id, u.username, c.manga_id, COALESCE(m.title, ''),
+	       c.start_chapter, c.chapters_per_week, c.schedule_start, c.reminded_week,
+	       (SELECT COUNT(*) FROM club_members cm WHERE cm.club_id = c.id),
+	       c.created_at, c.updated_at
+	FROM clubs c
+	JOIN users u ON u.id = c.owner_id
+	LEFT JOIN manga m ON m.id = c.manga_id
+`
+
+func scanClub(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*models.Club, error) {
+	var club models.Club
+	var mangaID sql.NullString
+	err := scanner.Scan(
+		&club.ID,
+		&club.Name,
+		&club.Description,
+		&club.OwnerID,
+		&club.Owner,
+		&mangaID,
+		&club.MangaTitle,
+		&club.StartChapter,
+		&club.ChaptersPerWeek,
+		&club.ScheduleStart,
+		&club.RemindedWeek,
+		&club.MemberCount,
+		&club.CreatedAt,
+		&club.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if mangaID.Valid {
+		club.MangaID = &mangaID.String
+	}
+	club.ChatRoom = models.ClubChatRoom(club.ID)
+	return &club, nil
+}
+
+func (r *Repository) listClubs(query string, args ...interface{}) ([]models.Club, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clubs: %w", err)
+	}
+	defer rows.Close()
+
+	clubs := []models.Club{}
+	for rows.Next() {
+		club, err := scanClub(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan club: %w", err)
+		}
+		clubs = append(clubs, *club)
+	}
+	return clubs, rows.Err()
+}
+
+// Insert creates a club with its owner as the first member
+func (r *Repository) Insert(club *models.Club) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO clubs (id, name, description, owner_id, manga_id, start_chapter, chapters_per_week, schedule_start, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, club.ID, club.Name, club.Description, club.OwnerID, club.MangaID, club.StartChapter, club.ChaptersPerWeek, club.ScheduleStart)
+	if err != nil {
+		return fmt.Errorf("failed to create club: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO club_members (club_id, user_id, joined_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+	`, club.ID, club.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to add club owner: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// FindByID finds a club
+func (r *Repository) FindByID(id string) (*models.Club, error) {
+	club, err := scanClub(r.db.QueryRow(clubSelect+` WHERE c.id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("club not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find club: %w", err)
+	}
+	return club, nil
+}
+
+// ListForUser returns the clubs a user is a member of, by name
+func (r *Repository) ListForUser(userID string) ([]models.Club, error) {
+	return r.listClubs(clubSelect+`
+		WHERE c.id IN (SELECT club_id FROM club_members WHERE user_id = ?)
+		ORDER BY c.name COLLATE NOCASE, c.id
+	`, userID)
+}
+
+// ListAll returns every club
+func (r *Repository) ListAll() ([]models.Club, error) {
+	return r.listClubs(clubSelect + ` ORDER BY c.created_at`)
+}
+
+// ListScheduled returns the clubs currently reading a manga
+func (r *Repository) ListScheduled() ([]models.Club, error) {
+	return r.listClubs(clubSelect + ` WHERE c.manga_id IS NOT NULL ORDER BY c.created_at`)
+}
+
+// Update saves a club's details and schedule
+func (r *Repository) Update(club *models.Club) error {
+	_, err := r.db.Exec(`
+		UPDATE clubs
+		SET name = ?, description = ?, manga_id = ?, start_chapter = ?, chapters_per_week = ?,
+		    schedule_start = ?, reminded_week = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, club.Name, club.Description, club.MangaID, club.StartChapter, club.ChaptersPerWeek,
+		club.ScheduleStart, club.RemindedWeek, club.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update club: %w", err)
+	}
+	return nil
+}
+
+// SetRemindedWeek records the last schedule week a club's members were reminded for
+func (r *Repository) SetRemindedWeek(clubID string, week int) error {
+	_, err := r.db.Exec(`UPDATE clubs SET reminded_week = ? WHERE id = ?`, week, clubID)
+	if err != nil {
+		return fmt.Errorf("failed to record club reminder: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a club and its memberships
+func (r *Repository) Delete(id string) error {
+	_, err := r.db.Exec(`DELETE FROM clubs WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete club: %w", err)
+	}
+	return nil
+}
+
+// AddMember adds a user to a club
+func (r *Repository) AddMember(clubID, userID string) error {
+	result, err := r.db.Exec(`
+		INSERT INTO club_members (club_id, user_id, joined_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(club_id, user_id) DO NOTHING
+	`, clubID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to add club member: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("already a member")
+	}
+	return nil
+}
+
+// RemoveMember removes a user from a club
+func (r *Repository) RemoveMember(clubID, userID string) error {
+	result, err := r.db.Exec(`DELETE FROM club_members WHERE club_id = ? AND user_id = ?`, clubID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove club member: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("not a member")
+	}
+	return nil
+}
+
+// IsMember reports whether a user is a member of a club
+func (r *Repository) IsMember(clubID, userID string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM club_members WHERE club_id = ? AND user_id = ?`, clubID, userID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check club membership: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Members returns the members of a club in the order they joined
+func (r *Repository) Members(clubID string) ([]models.ClubMember, error) {
+	rows, err := r.db.Query(`
+		SELECT u.username, cm.user_id = c.owner_id, cm.joined_at
+		FROM club_members cm
+		JOIN clubs c ON c.id = cm.club_id
+		JOIN users u ON u.id = cm.user_id
+		WHERE cm.club_id = ?
+		ORDER BY cm.joined_at, u.username
+	`, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list club members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []models.ClubMember{}
+	for rows.Next() {
+		var member models.ClubMember
+		if err := rows.Scan(&member.Username, &member.Owner, &member.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan club member: %w", err)
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+// MemberEntries returns each member of a club with their progress in a manga
+func (r *Repository) MemberEntries(clubID, mangaID string) ([]memberEntry, error) {
+	rows, err := r.db.Query(`
+		SELECT u.id, u.username, u.visibility, up.current_chapter, COALESCE(up.hidden, 0)
+		FROM club_members cm
+		JOIN users u ON u.id = cm.user_id
+		LEFT JOIN user_progress up ON up.user_id = cm.user_id AND up.manga_id = ?
+		WHERE cm.club_id = ?
+		ORDER BY u.username
+	`, mangaID, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list club progress: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []memberEntry
+	for rows.Next() {
+		var entry memberEntry
+		var chapter sql.NullFloat64
+		if err := rows.Scan(&entry.UserID, &entry.Username, &entry.Visibility, &chapter, &entry.Hidden); err != nil {
+			return nil, fmt.Errorf("failed to scan club progress: %w", err)
+		}
+		if chapter.Valid {
+			number := models.ChapterNumber(chapter.Float64)
+			entry.Chapter = &number
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,445 @@
+package club
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/internal/websocket"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// ChatRooms opens and closes the private chat rooms of clubs
+type ChatRooms interface {
+	CreatePrivateRoom(room string, access websocket.RoomAccess)
+	ClosePrivateRoom(room string)
+	RemoveFromRoom(room, userID string)
+}
+
+// Service handles reading club business logic
+type Service struct {
+	repo           *Repository
+	userService    *user.Service
+	mangaService   *manga.Service
+	profileService *profile.Service
+	chatRooms      ChatRooms
+}
+
+// NewService creates a new reading club service
+func NewService(repo *Repository, userService *user.Service, mangaService *manga.Service, profileService *profile.Service) *Service {
+	return &Service{repo: repo, userService: userService, mangaService: mangaService, profileService: profileService}
+}
+
+// UseChatRooms opens a members-only chat room for every club, now and as clubs are created
+func (s *Service) UseChatRooms(rooms ChatRooms) error {
+	s.chatRooms = rooms
+
+	clubs, err := s.repo.ListAll()
+	if err != nil {
+		return err
+	}
+	for _, club := range clubs {
+		s.openChatRoom(club.ID)
+	}
+	return nil
+}
+
+// openChatRoom creates a club's chat room, open to its current members
+func (s *Service) openChatRoom(clubID string) {
+	if s.chatRooms == nil {
+		return
+	}
+	s.chatRooms.CreatePrivateRoom(models.ClubChatRoom(clubID), func(userID string) bool {
+		member, err := s.repo.IsMember(clubID, userID)
+		if err != nil {
+			log.Printf("Failed to check club membership for chat: %v", err)
+			return false
+		}
+		return member
+	})
+}
+
+// findForMember loads a club the user is a member of
+func (s *Service) findForMember(userID, clubID string) (*models.Club, error) {
+	club, err := s.repo.FindByID(clubID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.repo.IsMember(clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		return nil, fmt.Errorf("not a club member")
+	}
+	return club, nil
+}
+
+// findForOwner loads a club the user owns
+func (s *Service) findForOwner(userID, clubID string) (*models.Club, error) {
+	club, err := s.findForMember(userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if club.OwnerID != userID {
+		return nil, fmt.Errorf("not club owner")
+	}
+	return club, nil
+}
+
+// checkSchedule validates the manga a club reads and the chapter it starts from
+func (s *Service) checkSchedule(mangaID *string, startChapter models.ChapterNumber) error {
+	if mangaID == nil {
+		if startChapter != 0 {
+			return fmt.Errorf("invalid schedule: a start chapter needs a manga")
+		}
+		return nil
+	}
+
+	manga, err := s.mangaService.GetByID(*mangaID)
+	if err != nil {
+		return err
+	}
+	if startChapter.Exceeds(manga.TotalChapters) {
+		return fmt.Errorf("invalid chapter number: exceeds total chapters (%d)", manga.TotalChapters)
+	}
+	return nil
+}
+
+// Create creates a club owned by the user, who becomes its first member
+func (s *Service) Create(ownerID string, req models.ClubCreateRequest) (*models.ClubDetails, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("invalid club: name cannot be empty")
+	}
+	if req.MangaID != nil && *req.MangaID == "" {
+		req.MangaID = nil
+	}
+	if err := s.checkSchedule(req.MangaID, req.StartChapter); err != nil {
+		return nil, err
+	}
+	if req.ChaptersPerWeek == 0 {
+		req.ChaptersPerWeek = 1
+	}
+
+	club := &models.Club{
+		ID:              uuid.New().String(),
+		Name:            name,
+		Description:     strings.TrimSpace(req.Description),
+		OwnerID:         ownerID,
+		MangaID:         req.MangaID,
+		StartChapter:    req.StartChapter,
+		ChaptersPerWeek: req.ChaptersPerWeek,
+		ScheduleStart:   time.Now().UTC(),
+	}
+	if err := s.repo.Insert(club); err != nil {
+		return nil, err
+	}
+	s.openChatRoom(club.ID)
+
+	return s.Get(ownerID, club.ID)
+}
+
+// List returns the clubs the user is a member of
+func (s *Service) List(userID string) ([]models.Club, error) {
+	return s.repo.ListForUser(userID)
+}
+
+// Get returns a club and its members; only members may see a club
+func (s *Service) Get(userID, clubID string) (*models.ClubDetails, error) {
+	club, err := s.findForMember(userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.Members(clubID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ClubDetails{Club: *club, Members: members}, nil
+}
+
+// Update changes a club's details and schedule. Changing the manga, start
+// chapter or pace restarts the schedule from now. Only the owner may update a club.
+func (s *Service) Update(userID, clubID string, req models.ClubUpdateRequest) (*models.ClubDetails, error) {
+	club, err := s.findForOwner(userID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("invalid club: name cannot be empty")
+		}
+		club.Name = name
+	}
+	if req.Description != nil {
+		club.Description = strings.TrimSpace(*req.Description)
+	}
+
+	rescheduled := false
+	if req.MangaID != nil {
+		if *req.MangaID == "" {
+			club.MangaID = nil
+			club.StartChapter = 0
+		} else {
+			club.MangaID = req.MangaID
+		}
+		rescheduled = true
+	}
+	if req.StartChapter != nil {
+		club.StartChapter = *req.StartChapter
+		rescheduled = true
+	}
+	if req.ChaptersPerWeek != nil {
+		club.ChaptersPerWeek = *req.ChaptersPerWeek
+		rescheduled = true
+	}
+
+	if rescheduled {
+		if err := s.checkSchedule(club.MangaID, club.StartChapter); err != nil {
+			return nil, err
+		}
+		club.ScheduleStart = time.Now().UTC()
+		club.RemindedWeek = 0
+	}
+
+	if err := s.repo.Update(club); err != nil {
+		return nil, err
+	}
+	return s.Get(userID, clubID)
+}
+
+// Delete removes a club and closes its chat room. Only the owner may delete a club.
+func (s *Service) Delete(userID, clubID string) error {
+	if _, err := s.findForOwner(userID, clubID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(clubID); err != nil {
+		return err
+	}
+	if s.chatRooms != nil {
+		s.chatRooms.ClosePrivateRoom(models.ClubChatRoom(clubID))
+	}
+	return nil
+}
+
+// AddMember adds the user with the given username to a club. Only the owner may add members.
+func (s *Service) AddMember(userID, clubID, username string) (*models.ClubDetails, error) {
+	if _, err := s.findForOwner(userID, clubID); err != nil {
+		return nil, err
+	}
+	member, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddMember(clubID, member.ID); err != nil {
+		return nil, err
+	}
+	return s.Get(userID, clubID)
+}
+
+// RemoveMember removes the user with the given username from a club and its
+// chat room. The owner may remove anyone else; members may remove themselves.
+func (s *Service) RemoveMember(userID, clubID, username string) error {
+	club, err := s.findForMember(userID, clubID)
+	if err != nil {
+		return err
+	}
+	member, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return err
+	}
+	if member.ID != userID && club.OwnerID != userID {
+		return fmt.Errorf("not club owner")
+	}
+	if member.ID == club.OwnerID {
+		return fmt.Errorf("invalid member: the owner cannot leave; delete the club instead")
+	}
+
+	if err := s.repo.RemoveMember(clubID, member.ID); err != nil {
+		return err
+	}
+	if s.chatRooms != nil {
+		s.chatRooms.RemoveFromRoom(models.ClubChatRoom(clubID), member.ID)
+	}
+	return nil
+}
+
+// Progress compares each member's progress in the club's manga with the
+// schedule. Members whose library the viewer may not see, and entries they
+// hide, are listed as hidden.
+func (s *Service) Progress(viewer *models.User, clubID string) (*models.ClubProgress, error) {
+	club, err := s.findForMember(viewer.ID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if club.MangaID == nil {
+		return nil, fmt.Errorf("invalid club: no manga is scheduled")
+	}
+
+	manga, err := s.mangaService.GetByID(*club.MangaID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.repo.MemberEntries(clubID, *club.MangaID)
+	if err != nil {
+		return nil, err
+	}
+
+	week := club.ScheduleWeek(time.Now())
+	due := club.TargetChapter(week-1, manga.TotalChapters)
+	target := club.TargetChapter(week, manga.TotalChapters)
+	progress := &models.ClubProgress{
+		Club:          *club,
+		Week:          week,
+		DueChapter:    &due,
+		TargetChapter: &target,
+		Members:       []models.ClubMemberProgress{},
+	}
+
+	for _, entry := range entries {
+		member := models.ClubMemberProgress{Username: entry.Username}
+
+		visible := entry.UserID == viewer.ID
+		if !visible && !entry.Hidden {
+			visible, err = s.profileService.CanView(viewer, &models.User{ID: entry.UserID, Visibility: entry.Visibility})
+			if err != nil {
+				return nil, fmt.Errorf("failed to check profile access: %w", err)
+			}
+		}
+
+		if visible {
+			member.Chapter = entry.Chapter
+			member.Status = ScheduleStatus(entry.Chapter, due, target)
+			if member.Status == models.ClubMemberBehind && entry.Chapter != nil {
+				behind := float64(due - *entry.Chapter)
+				member.ChaptersBehind = &behind
+			}
+		} else {
+			member.Status = models.ClubMemberHidden
+		}
+		progress.Members = append(progress.Members, member)
+	}
+
+	return progress, nil
+}
+
+// ScheduleStatus places a member's chapter (nil when not started) against the
+// chapter due by the end of last week and the target for this week
+func ScheduleStatus(chapter *models.ChapterNumber, due, target models.ChapterNumber) string {
+	switch {
+	case chapter == nil:
+		return models.ClubMemberNotStarted
+	case *chapter >= target:
+		return models.ClubMemberAhead
+	case *chapter >= due:
+		return models.ClubMemberOnTrack
+	default:
+		return models.ClubMemberBehind
+	}
+}
+
+// Remind sends this week's reading target to the members who have not reached
+// it yet and returns how many were reminded. Only the owner may send reminders.
+func (s *Service) Remind(userID, clubID string) (int, error) {
+	club, err := s.findForOwner(userID, clubID)
+	if err != nil {
+		return 0, err
+	}
+	if club.MangaID == nil {
+		return 0, fmt.Errorf("invalid club: no manga is scheduled")
+	}
+	return s.remind(club, time.Now())
+}
+
+// remind sends the reminders of the schedule week containing now
+func (s *Service) remind(club *models.Club, now time.Time) (int, error) {
+	manga, err := s.mangaService.GetByID(*club.MangaID)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := s.repo.MemberEntries(club.ID, manga.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	week := club.ScheduleWeek(now)
+	due := club.TargetChapter(week-1, manga.TotalChapters)
+	target := club.TargetChapter(week, manga.TotalChapters)
+
+	sent := 0
+	for _, entry := range entries {
+		if entry.Chapter != nil && *entry.Chapter >= target {
+			continue
+		}
+
+		message := fmt.Sprintf("%s, week %d: read %s up to chapter %s", club.Name, week, manga.Title, target)
+		if entry.Chapter == nil {
+			message += " (not in your library yet)"
+		} else if *entry.Chapter < due {
+			message += fmt.Sprintf(" (you are on chapter %s)", *entry.Chapter)
+		}
+
+		s.mangaService.NotifyNotification(models.UDPNotification{
+			UserID:        entry.UserID,
+			MangaID:       manga.ID,
+			MangaTitle:    manga.Title,
+			ChapterNumber: target,
+			ReleaseDate:   now,
+			Message:       message,
+		})
+		sent++
+	}
+
+	return sent, nil
+}
+
+// SendDueReminders reminds members of every club whose schedule entered a new
+// week since its last reminders
+func (s *Service) SendDueReminders(now time.Time) error {
+	clubs, err := s.repo.ListScheduled()
+	if err != nil {
+		return err
+	}
+
+	for i := range clubs {
+		club := &clubs[i]
+		week := club.ScheduleWeek(now)
+		if week <= club.RemindedWeek {
+			continue
+		}
+		if _, err := s.remind(club, now); err != nil {
+			log.Printf("Failed to send reminders for club %s: %v", club.ID, err)
+			continue
+		}
+		if err := s.repo.SetRemindedWeek(club.ID, week); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunReminders sends due club reminders every interval until ctx is cancelled
+func (s *Service) RunReminders(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SendDueReminders(time.Now()); err != nil {
+			log.Printf("Failed to send club reminders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package comment
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles chapter comment HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new chapter comment handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "comment not found":
+		response.NotFound(c, "Comment not found")
+	case err.Error() == "manga not found":
+		response.NotFound(c, "Manga not found")
+	case err.Error() == "not comment author":
+		response.Forbidden(c, "Only the author or an admin can change this comment")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// List returns a chapter's comment threads
+// GET /manga/:id/chapters/:n/comments?sort=newest|oldest&limit=20&offset=0
+func (h *Handler) List(c *gin.Context) {
+	var query models.CommentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	comments, total, err := h.service.List(c.Param("id"), c.Param("n"), query)
+	if err != nil {
+		respondError(c, err, "Failed to list comments")
+		return
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = defaultCommentLimit
+	}
+	response.Paginated(c, comments, total, query.Limit, query.Offset)
+}
+
+// Get returns a comment with its replies
+// GET /manga/:id/chapters/:n/comments/:comment_id
+func (h *Handler) Get(c *gin.Context) {
+	comment, err := h.service.Get(c.Param("id"), c.Param("n"), c.Param("comment_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get comment")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"comment": comment})
+}
+
+// Post adds a comment or a reply to a chapter
+// POST /manga/:id/chapters/:n/comments
+func (h *Handler) Post(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.CommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	comment, err := h.service.Post(user.ID, c.Param("id"), c.Param("n"), req)
+	if err != nil {
+		respondError(c, err, "Failed to post comment")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"comment": comment})
+}
+
+// Edit changes a comment's body and spoiler flag
+// PUT /manga/:id/chapters/:n/comments/:comment_id
+func (h *Handler) Edit(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.CommentUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	comment, err := h.service.Edit(user, c.Param("id"), c.Param("n"), c.Param("comment_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to edit comment")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"comment": comment})
+}
+
+// Delete soft-deletes a comment
+// DELETE /manga/:id/chapters/:n/comments/:comment_id
+func (h *Handler) Delete(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(user, c.Param("id"), c.Param("n"), c.Param("comment_id")); err != nil {
+		respondError(c, err, "Failed to delete comment")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Comment deleted"})
+}
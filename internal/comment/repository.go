@@ -0,0 +1,186 @@
+package comment
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles chapter comment data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new chapter comment repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const commentSelect = `
+	SELECT c.id, c.manga_id, c.chapter, c.parent_id, c.user_id, u.username,
+	       c.body, c.spoiler, c.created_at, c.edited_at, c.deleted_at
+	FROM chapter_comments c
+	JOIN users u ON u.id = c.user_id
+`
+
+func scanComment(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*models.ChapterComment, error) {
+	var comment models.ChapterComment
+	var parentID sql.NullString
+	var editedAt, deletedAt sql.NullTime
+	err := scanner.Scan(
+		&comment.ID,
+		&comment.MangaID,
+		&comment.Chapter,
+		&parentID,
+		&comment.UserID,
+		&comment.Username,
+		&comment.Body,
+		&comment.Spoiler,
+		&comment.CreatedAt,
+		&editedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if parentID.Valid {
+		comment.ParentID = &parentID.String
+	}
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+	comment.Deleted = deletedAt.Valid
+	return &comment, nil
+}
+
+func scanComments(rows *sql.Rows) ([]models.ChapterComment, error) {
+	defer rows.Close()
+
+	comments := []models.ChapterComment{}
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, *comment)
+	}
+	return comments, rows.Err()
+}
+
+// Insert stores a new comment. rootID is the top-level comment of a reply's thread.
+func (r *Repository) Insert(comment *models.ChapterComment, rootID *string) error {
+	_, err := r.db.Exec(`
+		INSERT INTO chapter_comments (id, manga_id, chapter, user_id, parent_id, root_id, body, spoiler, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, comment.ID, comment.MangaID, comment.Chapter, comment.UserID, comment.ParentID, rootID, comment.Body, comment.Spoiler)
+	if err != nil {
+		return fmt.Errorf("failed to save comment: %w", err)
+	}
+	return nil
+}
+
+// FindByID finds a comment
+func (r *Repository) FindByID(id string) (*models.ChapterComment, error) {
+	comment, err := scanComment(r.db.QueryRow(commentSelect+` WHERE c.id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("comment not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+	return comment, nil
+}
+
+// ThreadRootID returns the top-level comment of the thread a comment belongs to
+func (r *Repository) ThreadRootID(id string) (string, error) {
+	var rootID string
+	err := r.db.QueryRow(`SELECT COALESCE(root_id, id) FROM chapter_comments WHERE id = ?`, id).Scan(&rootID)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("comment not found")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find comment thread: %w", err)
+	}
+	return rootID, nil
+}
+
+// ListTopLevel returns a page of a chapter's top-level comments with their total count
+func (r *Repository) ListTopLevel(mangaID string, chapter models.ChapterNumber, sort string, limit, offset int) ([]models.ChapterComment, int, error) {
+	var total int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM chapter_comments
+		WHERE manga_id = ? AND chapter = ? AND parent_id IS NULL
+	`, mangaID, chapter).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	order := "c.created_at DESC, c.id DESC"
+	if sort == models.CommentSortOldest {
+		order = "c.created_at ASC, c.id ASC"
+	}
+
+	rows, err := r.db.Query(commentSelect+`
+		WHERE c.manga_id = ? AND c.chapter = ? AND c.parent_id IS NULL
+		ORDER BY `+order+`
+		LIMIT ? OFFSET ?
+	`, mangaID, chapter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	comments, err := scanComments(rows)
+	return comments, total, err
+}
+
+// ListReplies returns every reply in the given threads, oldest first
+func (r *Repository) ListReplies(rootIDs []string) ([]models.ChapterComment, error) {
+	if len(rootIDs) == 0 {
+		return []models.ChapterComment{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(rootIDs)), ",")
+	args := make([]interface{}, len(rootIDs))
+	for i, id := range rootIDs {
+		args[i] = id
+	}
+
+	rows, err := r.db.Query(commentSelect+`
+		WHERE c.root_id IN (`+placeholders+`)
+		ORDER BY c.created_at ASC, c.id ASC
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replies: %w", err)
+	}
+
+	return scanComments(rows)
+}
+
+// Update replaces a comment's body and spoiler flag and marks it edited
+func (r *Repository) Update(id, body string, spoiler bool) error {
+	_, err := r.db.Exec(`
+		UPDATE chapter_comments SET body = ?, spoiler = ?, edited_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND deleted_at IS NULL
+	`, body, spoiler, id)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
+
+// SoftDelete marks a comment deleted, keeping its row so replies stay in place
+func (r *Repository) SoftDelete(id string, at time.Time) error {
+	_, err := r.db.Exec(`
+		UPDATE chapter_comments SET deleted_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const defaultCommentLimit = 20
+
+// Service handles chapter comment business logic
+type Service struct {
+	repo         *Repository
+	mangaService *manga.Service
+}
+
+// NewService creates a new chapter comment service
+func NewService(repo *Repository, mangaService *manga.Service) *Service {
+	return &Service{repo: repo, mangaService: mangaService}
+}
+
+// chapter validates the manga and chapter a comment thread belongs to
+func (s *Service) chapter(mangaID, chapter string) (models.ChapterNumber, error) {
+	manga, err := s.mangaService.GetByID(mangaID)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := models.ParseChapterNumber(chapter)
+	if err != nil {
+		return 0, err
+	}
+	if number.Exceeds(manga.TotalChapters) {
+		return 0, fmt.Errorf("invalid chapter number: exceeds total chapters (%d)", manga.TotalChapters)
+	}
+	return number, nil
+}
+
+// find loads a comment and checks it belongs to the given chapter
+func (s *Service) find(mangaID string, chapter models.ChapterNumber, commentID string) (*models.ChapterComment, error) {
+	comment, err := s.repo.FindByID(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.MangaID != mangaID || comment.Chapter != chapter {
+		return nil, fmt.Errorf("comment not found")
+	}
+	return comment, nil
+}
+
+// findEditable loads a comment the user may edit or delete
+func (s *Service) findEditable(user *models.User, mangaID, chapter, commentID string) (*models.ChapterComment, error) {
+	number, err := s.chapter(mangaID, chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.find(mangaID, number, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Deleted {
+		return nil, fmt.Errorf("comment not found")
+	}
+	if !CanModify(user, comment) {
+		return nil, fmt.Errorf("not comment author")
+	}
+	return comment, nil
+}
+
+// List returns a page of a chapter's top-level comments with their replies nested below them
+func (s *Service) List(mangaID, chapter string, query models.CommentQuery) ([]models.ChapterComment, int, error) {
+	number, err := s.chapter(mangaID, chapter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if query.Sort == "" {
+		query.Sort = models.CommentSortNewest
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultCommentLimit
+	}
+
+	comments, total, err := s.repo.ListTopLevel(mangaID, number, query.Sort, query.Limit, query.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rootIDs := make([]string, len(comments))
+	for i, comment := range comments {
+		rootIDs[i] = comment.ID
+	}
+	replies, err := s.repo.ListReplies(rootIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return BuildThreads(comments, replies), total, nil
+}
+
+// Get returns a comment with the replies below it
+func (s *Service) Get(mangaID, chapter, commentID string) (*models.ChapterComment, error) {
+	number, err := s.chapter(mangaID, chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.find(mangaID, number, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	rootID, err := s.repo.ThreadRootID(comment.ID)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := s.repo.ListReplies([]string{rootID})
+	if err != nil {
+		return nil, err
+	}
+
+	thread := BuildThreads([]models.ChapterComment{*comment}, replies)
+	return &thread[0], nil
+}
+
+// Post adds a comment to a chapter, or a reply when req.ParentID is set
+func (s *Service) Post(userID, mangaID, chapter string, req models.CommentRequest) (*models.ChapterComment, error) {
+	number, err := s.chapter(mangaID, chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, fmt.Errorf("invalid comment: body cannot be empty")
+	}
+
+	var rootID *string
+	if req.ParentID != nil {
+		parent, err := s.repo.FindByID(*req.ParentID)
+		if err != nil || parent.MangaID != mangaID || parent.Chapter != number {
+			return nil, fmt.Errorf("invalid reply: parent comment not found in this chapter")
+		}
+		if parent.Deleted {
+			return nil, fmt.Errorf("invalid reply: parent comment was deleted")
+		}
+
+		root, err := s.repo.ThreadRootID(parent.ID)
+		if err != nil {
+			return nil, err
+		}
+		rootID = &root
+	}
+
+	comment := &models.ChapterComment{
+		ID:       uuid.New().String(),
+		MangaID:  mangaID,
+		Chapter:  number,
+		ParentID: req.ParentID,
+		UserID:   userID,
+		Body:     body,
+		Spoiler:  req.Spoiler,
+	}
+	if err := s.repo.Insert(comment, rootID); err != nil {
+		return nil, err
+	}
+
+	return s.Get(mangaID, chapter, comment.ID)
+}
+
+// Edit changes a comment's body and, when given, its spoiler flag.
+// Only the author or an admin may edit a comment.
+func (s *Service) Edit(user *models.User, mangaID, chapter, commentID string, req models.CommentUpdateRequest) (*models.ChapterComment, error) {
+	comment, err := s.findEditable(user, mangaID, chapter, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, fmt.Errorf("invalid comment: body cannot be empty")
+	}
+	spoiler := comment.Spoiler
+	if req.Spoiler != nil {
+		spoiler = *req.Spoiler
+	}
+
+	if err := s.repo.Update(comment.ID, body, spoiler); err != nil {
+		return nil, err
+	}
+
+	return s.Get(mangaID, chapter, comment.ID)
+}
+
+// Delete soft-deletes a comment. Its replies stay in the thread.
+// Only the author or an admin may delete a comment.
+func (s *Service) Delete(user *models.User, mangaID, chapter, commentID string) error {
+	comment, err := s.findEditable(user, mangaID, chapter, commentID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.SoftDelete(comment.ID, time.Now())
+}
+
+// CanModify reports whether the user may edit or delete a comment
+func CanModify(user *models.User, comment *models.ChapterComment) bool {
+	return user.ID == comment.UserID || user.IsAdmin()
+}
+
+// BuildThreads nests replies under the comments they answer, oldest first,
+// and hides the author and body of deleted comments. replies may hold any
+// comments from the threads of roots; those without a parent among them are dropped.
+func BuildThreads(roots, replies []models.ChapterComment) []models.ChapterComment {
+	children := make(map[string][]models.ChapterComment)
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+
+	var attach func(comment *models.ChapterComment)
+	attach = func(comment *models.ChapterComment) {
+		if comment.Deleted {
+			comment.UserID = ""
+			comment.Username = ""
+			comment.Body = ""
+			comment.Spoiler = false
+		}
+
+		comment.Replies = []models.ChapterComment{}
+		comment.ReplyCount = 0
+		for _, child := range children[comment.ID] {
+			attach(&child)
+			comment.Replies = append(comment.Replies, child)
+			comment.ReplyCount += 1 + child.ReplyCount
+		}
+	}
+
+	threads := make([]models.ChapterComment, len(roots))
+	for i := range roots {
+		threads[i] = roots[i]
+		attach(&threads[i])
+	}
+	return threads
+}
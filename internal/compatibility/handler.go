@@ -0,0 +1,52 @@
+package compatibility
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles compatibility HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new compatibility handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "user not found":
+		response.NotFound(c, "User not found")
+	case err.Error() == "library is private":
+		response.Forbidden(c, "This library is private")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// Compare returns how alike the caller's and another user's reading taste is
+// GET /users/:username/compatibility
+func (h *Handler) Compare(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	compatibility, err := h.service.Compare(userInterface.(*models.User), c.Param("username"))
+	if err != nil {
+		respondError(c, err, "Failed to compare libraries")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"compatibility": compatibility})
+}
@@ -0,0 +1,267 @@
+package compatibility
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const (
+	// minRatedInCommon is how many titles both users must have rated for a rating correlation
+	minRatedInCommon = 3
+	// maxTitleComparisons caps the agreement and disagreement lists
+	maxTitleComparisons = 5
+	// Ratings this close count as agreeing, this far apart as disagreeing
+	agreeWithin     = 1
+	disagreeAtLeast = 3
+)
+
+// Score weights of the components; missing components are left out and the rest rescaled
+const (
+	ratingWeight     = 0.5
+	genreWeight      = 0.3
+	completionWeight = 0.2
+)
+
+// Service handles taste compatibility between users
+type Service struct {
+	userService    *user.Service
+	mangaService   *manga.Service
+	profileService *profile.Service
+}
+
+// NewService creates a new compatibility service
+func NewService(userService *user.Service, mangaService *manga.Service, profileService *profile.Service) *Service {
+	return &Service{userService: userService, mangaService: mangaService, profileService: profileService}
+}
+
+// Compare compares the viewer's library with that of the user with the given
+// username. The other user's privacy settings apply, and their hidden entries
+// are left out.
+func (s *Service) Compare(viewer *models.User, username string) (*models.Compatibility, error) {
+	other, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if other.ID == viewer.ID {
+		return nil, fmt.Errorf("invalid comparison: cannot compare with yourself")
+	}
+
+	allowed, err := s.profileService.CanView(viewer, other)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check profile access: %w", err)
+	}
+	if !allowed {
+		return nil, fmt.Errorf("library is private")
+	}
+
+	mine, _, err := s.mangaService.GetUserLibrary(viewer.ID, models.LibraryQuery{})
+	if err != nil {
+		return nil, err
+	}
+	theirs, _, err := s.mangaService.GetUserLibrary(other.ID, models.LibraryQuery{ExcludeHidden: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result := CompareLibraries(mine, theirs)
+	result.Username = other.Username
+	return result, nil
+}
+
+// CompareLibraries scores how alike two libraries are. Ratings of titles in
+// both libraries are correlated, the genres of everything started are
+// compared, and completions in common are counted.
+func CompareLibraries(mine, theirs []models.UserProgressWithManga) *models.Compatibility {
+	result := &models.Compatibility{
+		Agreements:    []models.TitleComparison{},
+		Disagreements: []models.TitleComparison{},
+	}
+
+	theirEntries := make(map[string]models.UserProgressWithManga, len(theirs))
+	for _, entry := range theirs {
+		theirEntries[entry.MangaID] = entry
+	}
+
+	var rated []models.TitleComparison
+	myCompleted, theirCompleted := 0, 0
+	for _, entry := range theirs {
+		if entry.Status == models.ReadingStatusCompleted {
+			theirCompleted++
+		}
+	}
+	for _, entry := range mine {
+		if entry.Status == models.ReadingStatusCompleted {
+			myCompleted++
+		}
+
+		other, shared := theirEntries[entry.MangaID]
+		if !shared {
+			continue
+		}
+		result.SharedTitles++
+		if entry.Status == models.ReadingStatusCompleted && other.Status == models.ReadingStatusCompleted {
+			result.SharedCompletions++
+		}
+		if entry.Rating != nil && other.Rating != nil {
+			rated = append(rated, models.TitleComparison{
+				MangaID:     entry.MangaID,
+				Title:       entry.Manga.Title,
+				MyRating:    *entry.Rating,
+				TheirRating: *other.Rating,
+			})
+		}
+	}
+	result.RatedInCommon = len(rated)
+
+	if len(rated) >= minRatedInCommon {
+		result.RatingCorrelation = ratingCorrelation(rated)
+	}
+	result.GenreOverlap = genreOverlap(genreCounts(mine), genreCounts(theirs))
+	if union := myCompleted + theirCompleted - result.SharedCompletions; union > 0 {
+		overlap := round2(float64(result.SharedCompletions) / float64(union))
+		result.CompletionOverlap = &overlap
+	}
+
+	result.Agreements, result.Disagreements = splitByAgreement(rated)
+	result.Score = score(result)
+	return result
+}
+
+// ratingCorrelation returns the Pearson correlation of the two users' ratings,
+// or nil when either user gave every title the same rating
+func ratingCorrelation(rated []models.TitleComparison) *float64 {
+	n := float64(len(rated))
+	var sumMine, sumTheirs float64
+	for _, title := range rated {
+		sumMine += float64(title.MyRating)
+		sumTheirs += float64(title.TheirRating)
+	}
+	meanMine, meanTheirs := sumMine/n, sumTheirs/n
+
+	var cov, varMine, varTheirs float64
+	for _, title := range rated {
+		dm := float64(title.MyRating) - meanMine
+		dt := float64(title.TheirRating) - meanTheirs
+		cov += dm * dt
+		varMine += dm * dm
+		varTheirs += dt * dt
+	}
+	if varMine == 0 || varTheirs == 0 {
+		return nil
+	}
+
+	correlation := round2(cov / math.Sqrt(varMine*varTheirs))
+	return &correlation
+}
+
+// genreCounts counts the genres of the titles a user has started
+func genreCounts(library []models.UserProgressWithManga) map[string]float64 {
+	counts := make(map[string]float64)
+	for _, entry := range library {
+		if entry.Status == models.ReadingStatusPlanToRead {
+			continue
+		}
+		for _, genre := range entry.Manga.Genres {
+			counts[genre]++
+		}
+	}
+	return counts
+}
+
+// genreOverlap returns the cosine similarity of two genre counts, or nil when either is empty
+func genreOverlap(mine, theirs map[string]float64) *float64 {
+	if len(mine) == 0 || len(theirs) == 0 {
+		return nil
+	}
+
+	var dot, normMine, normTheirs float64
+	for genre, count := range mine {
+		dot += count * theirs[genre]
+		normMine += count * count
+	}
+	for _, count := range theirs {
+		normTheirs += count * count
+	}
+
+	overlap := round2(dot / math.Sqrt(normMine*normTheirs))
+	return &overlap
+}
+
+// splitByAgreement picks the titles rated most alike, best rated first, and
+// the titles rated furthest apart
+func splitByAgreement(rated []models.TitleComparison) (agreements, disagreements []models.TitleComparison) {
+	agreements = []models.TitleComparison{}
+	disagreements = []models.TitleComparison{}
+	for _, title := range rated {
+		switch gap := ratingGap(title); {
+		case gap <= agreeWithin:
+			agreements = append(agreements, title)
+		case gap >= disagreeAtLeast:
+			disagreements = append(disagreements, title)
+		}
+	}
+
+	sort.SliceStable(agreements, func(i, j int) bool {
+		a, b := agreements[i], agreements[j]
+		if sa, sb := a.MyRating+a.TheirRating, b.MyRating+b.TheirRating; sa != sb {
+			return sa > sb
+		}
+		return a.Title < b.Title
+	})
+	sort.SliceStable(disagreements, func(i, j int) bool {
+		a, b := disagreements[i], disagreements[j]
+		if ga, gb := ratingGap(a), ratingGap(b); ga != gb {
+			return ga > gb
+		}
+		return a.Title < b.Title
+	})
+
+	if len(agreements) > maxTitleComparisons {
+		agreements = agreements[:maxTitleComparisons]
+	}
+	if len(disagreements) > maxTitleComparisons {
+		disagreements = disagreements[:maxTitleComparisons]
+	}
+	return agreements, disagreements
+}
+
+func ratingGap(title models.TitleComparison) int {
+	gap := title.MyRating - title.TheirRating
+	if gap < 0 {
+		return -gap
+	}
+	return gap
+}
+
+// score combines the available components into a 0-100 score
+func score(result *models.Compatibility) *int {
+	var total, weights float64
+	if result.RatingCorrelation != nil {
+		total += ratingWeight * (*result.RatingCorrelation + 1) / 2
+		weights += ratingWeight
+	}
+	if result.GenreOverlap != nil {
+		total += genreWeight * *result.GenreOverlap
+		weights += genreWeight
+	}
+	if result.CompletionOverlap != nil {
+		total += completionWeight * *result.CompletionOverlap
+		weights += completionWeight
+	}
+	if weights == 0 {
+		return nil
+	}
+
+	value := int(math.Round(100 * total / weights))
+	return &value
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,144 @@
+package follow
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles follow HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new follow handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// viewer returns the signed-in user, or nil for anonymous requests
+func viewer(c *gin.Context) *models.User {
+	if userInterface, exists := c.Get("user"); exists {
+		return userInterface.(*models.User)
+	}
+	return nil
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "user not found":
+		response.NotFound(c, "User not found")
+	case err.Error() == "already following":
+		response.Conflict(c, "Already following this user")
+	case err.Error() == "not following":
+		response.NotFound(c, "Not following this user")
+	case err.Error() == "profile is private":
+		response.Forbidden(c, "This profile is private")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// Follow starts following a user
+// POST /users/:username/follow
+func (h *Handler) Follow(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Follow(user.ID, c.Param("username")); err != nil {
+		respondError(c, err, "Failed to follow user")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"message": "Now following " + c.Param("username")})
+}
+
+// Unfollow stops following a user
+// DELETE /users/:username/follow
+func (h *Handler) Unfollow(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Unfollow(user.ID, c.Param("username")); err != nil {
+		respondError(c, err, "Failed to unfollow user")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "No longer following " + c.Param("username")})
+}
+
+// RemoveFollower makes a user stop following the current user
+// DELETE /users/me/followers/:username
+func (h *Handler) RemoveFollower(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveFollower(user.ID, c.Param("username")); err != nil {
+		if err.Error() == "not following" {
+			response.NotFound(c, "This user does not follow you")
+			return
+		}
+		respondError(c, err, "Failed to remove follower")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Follower removed"})
+}
+
+// Followers lists a user's followers
+// GET /users/:username/followers?limit=50&offset=0
+func (h *Handler) Followers(c *gin.Context) {
+	var query models.FollowListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	users, total, err := h.service.Followers(viewer(c), c.Param("username"), query)
+	if err != nil {
+		respondError(c, err, "Failed to list followers")
+		return
+	}
+
+	response.Paginated(c, users, total, listLimit(query), query.Offset)
+}
+
+// Following lists the users a user follows
+// GET /users/:username/following?limit=50&offset=0
+func (h *Handler) Following(c *gin.Context) {
+	var query models.FollowListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	users, total, err := h.service.Following(viewer(c), c.Param("username"), query)
+	if err != nil {
+		respondError(c, err, "Failed to list followed users")
+		return
+	}
+
+	response.Paginated(c, users, total, listLimit(query), query.Offset)
+}
@@ -0,0 +1,118 @@
+package follow
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles follow data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new follow repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Insert records that followerID follows followeeID
+func (r *Repository) Insert(followerID, followeeID string) error {
+	result, err := r.db.Exec(`
+		INSERT INTO follows (follower_id, followee_id, created_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(follower_id, followee_id) DO NOTHING
+	`, followerID, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to follow user: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("already following")
+	}
+	return nil
+}
+
+// Delete removes the follow of followeeID by followerID
+func (r *Repository) Delete(followerID, followeeID string) error {
+	result, err := r.db.Exec(`DELETE FROM follows WHERE follower_id = ? AND followee_id = ?`, followerID, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("not following")
+	}
+	return nil
+}
+
+// Exists reports whether followerID follows followeeID
+func (r *Repository) Exists(followerID, followeeID string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM follows WHERE follower_id = ? AND followee_id = ?`, followerID, followeeID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check follow: %w", err)
+	}
+	return count > 0, nil
+}
+
+// FollowerIDs returns the IDs of every user following userID
+func (r *Repository) FollowerIDs(userID string) ([]string, error) {
+	rows, err := r.db.Query(`SELECT follower_id FROM follows WHERE followee_id = ?`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followers: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan follower: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ListFollowers returns the users following userID, most recent first, with the total count
+func (r *Repository) ListFollowers(userID string, limit, offset int) ([]models.FollowedUser, int, error) {
+	return r.list(`f.followee_id = ?`, `f.follower_id`, userID, limit, offset)
+}
+
+// ListFollowing returns the users userID follows, most recent first, with the total count
+func (r *Repository) ListFollowing(userID string, limit, offset int) ([]models.FollowedUser, int, error) {
+	return r.list(`f.follower_id = ?`, `f.followee_id`, userID, limit, offset)
+}
+
+// list pages through follows matching where, listing the user in the other column
+func (r *Repository) list(where, otherColumn, userID string, limit, offset int) ([]models.FollowedUser, int, error) {
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM follows f WHERE `+where, userID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count follows: %w", err)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT u.username, f.created_at
+		FROM follows f
+		JOIN users u ON u.id = `+otherColumn+`
+		WHERE `+where+`
+		ORDER BY f.created_at DESC, u.username ASC
+		LIMIT ? OFFSET ?
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list follows: %w", err)
+	}
+	defer rows.Close()
+
+	users := []models.FollowedUser{}
+	for rows.Next() {
+		var user models.FollowedUser
+		if err := rows.Scan(&user.Username, &user.FollowedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan follow: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, total, rows.Err()
+}
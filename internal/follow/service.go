@@ -0,0 +1,136 @@
+package follow
+
+import (
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const defaultFollowListLimit = 50
+
+// Service handles follow business logic
+type Service struct {
+	repo           *Repository
+	userService    *user.Service
+	mangaService   *manga.Service
+	profileService *profile.Service
+}
+
+// NewService creates a new follow service
+func NewService(repo *Repository, userService *user.Service, mangaService *manga.Service, profileService *profile.Service) *Service {
+	return &Service{repo: repo, userService: userService, mangaService: mangaService, profileService: profileService}
+}
+
+// Follow makes the user follow the user with the given username
+func (s *Service) Follow(followerID, username string) error {
+	followee, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return err
+	}
+	if followee.ID == followerID {
+		return fmt.Errorf("invalid follow: cannot follow yourself")
+	}
+	return s.repo.Insert(followerID, followee.ID)
+}
+
+// Unfollow stops the user following the user with the given username
+func (s *Service) Unfollow(followerID, username string) error {
+	followee, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(followerID, followee.ID)
+}
+
+// RemoveFollower stops the user with the given username following the user
+func (s *Service) RemoveFollower(userID, username string) error {
+	follower, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(follower.ID, userID)
+}
+
+// IsFollowing reports whether followerID follows userID
+func (s *Service) IsFollowing(followerID, userID string) (bool, error) {
+	return s.repo.Exists(followerID, userID)
+}
+
+// BroadcastAudience decides who may receive a live broadcast of a user's progress.
+// The user's own devices always do; followers too when the user is followers-only,
+// and everyone when the user is public. Hidden entries stay on the user's devices.
+func (s *Service) BroadcastAudience(broadcast models.TCPProgressBroadcast) (models.BroadcastAudience, error) {
+	owner := models.BroadcastAudience{UserIDs: []string{broadcast.UserID}}
+
+	user, err := s.userService.GetByID(broadcast.UserID)
+	if err != nil {
+		return owner, err
+	}
+
+	progress, err := s.mangaService.GetProgress(broadcast.UserID, broadcast.MangaID)
+	if err != nil {
+		return owner, err
+	}
+	if progress.Hidden {
+		return owner, nil
+	}
+
+	switch user.Visibility {
+	case models.ProfileVisibilityPublic:
+		return models.BroadcastAudience{Public: true}, nil
+	case models.ProfileVisibilityFollowers:
+		followers, err := s.repo.FollowerIDs(user.ID)
+		if err != nil {
+			return owner, err
+		}
+		return models.BroadcastAudience{UserIDs: append(owner.UserIDs, followers...)}, nil
+	default:
+		return owner, nil
+	}
+}
+
+// Followers lists who follows a user, as seen by viewer
+func (s *Service) Followers(viewer *models.User, username string, query models.FollowListQuery) ([]models.FollowedUser, int, error) {
+	owner, err := s.visibleUser(viewer, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListFollowers(owner.ID, listLimit(query), query.Offset)
+}
+
+// Following lists who a user follows, as seen by viewer
+func (s *Service) Following(viewer *models.User, username string, query models.FollowListQuery) ([]models.FollowedUser, int, error) {
+	owner, err := s.visibleUser(viewer, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListFollowing(owner.ID, listLimit(query), query.Offset)
+}
+
+// visibleUser finds a user whose follows viewer may see. Follow lists share
+// the privacy setting of the user's library.
+func (s *Service) visibleUser(viewer *models.User, username string) (*models.User, error) {
+	owner, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.profileService.CanView(viewer, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check profile access: %w", err)
+	}
+	if !allowed {
+		return nil, fmt.Errorf("profile is private")
+	}
+	return owner, nil
+}
+
+func listLimit(query models.FollowListQuery) int {
+	if query.Limit <= 0 {
+		return defaultFollowListLimit
+	}
+	return query.Limit
+}
@@ -0,0 +1,140 @@
+package goal
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles reading goal HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new goal handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "goal not found":
+		response.NotFound(c, "Goal not found")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// List returns the user's goals with their progress in the current period
+// GET /users/goals
+func (h *Handler) List(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	goals, err := h.service.List(user.ID)
+	if err != nil {
+		respondError(c, err, "Failed to list goals")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": goals}, &response.Meta{
+		Count: len(goals),
+	})
+}
+
+// Get returns a single goal with its progress
+// GET /users/goals/:goal_id
+func (h *Handler) Get(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	goal, err := h.service.Get(user.ID, c.Param("goal_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get goal")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"goal": goal})
+}
+
+// Create creates a reading goal
+// POST /users/goals
+func (h *Handler) Create(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.GoalCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	goal, err := h.service.Create(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to create goal")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"goal": goal})
+}
+
+// Update changes a reading goal
+// PUT /users/goals/:goal_id
+func (h *Handler) Update(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.GoalUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	goal, err := h.service.Update(user.ID, c.Param("goal_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to update goal")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"goal": goal})
+}
+
+// Delete removes a reading goal
+// DELETE /users/goals/:goal_id
+func (h *Handler) Delete(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(user.ID, c.Param("goal_id")); err != nil {
+		respondError(c, err, "Failed to delete goal")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Goal deleted"})
+}
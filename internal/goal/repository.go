@@ -0,0 +1,187 @@
+package goal
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles reading goal data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new goal repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const goalSelectFields = `id, user_id, metric, genre, period, target, achieved_period, created_at, updated_at`
+
+func scanGoal(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*models.ReadingGoal, error) {
+	var goal models.ReadingGoal
+	err := scanner.Scan(
+		&goal.ID,
+		&goal.UserID,
+		&goal.Metric,
+		&goal.Genre,
+		&goal.Period,
+		&goal.Target,
+		&goal.AchievedPeriod,
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &goal, nil
+}
+
+// Create inserts a new goal
+func (r *Repository) Create(goal *models.ReadingGoal) error {
+	query := `
+		INSERT INTO reading_goals (id, user_id, metric, genre, period, target, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`
+	_, err := r.db.Exec(query, goal.ID, goal.UserID, goal.Metric, goal.Genre, goal.Period, goal.Target)
+	if err != nil {
+		return fmt.Errorf("failed to create goal: %w", err)
+	}
+	return nil
+}
+
+// FindByID finds one of the user's goals by ID
+func (r *Repository) FindByID(userID, goalID string) (*models.ReadingGoal, error) {
+	query := fmt.Sprintf(`SELECT %s FROM reading_goals WHERE user_id = ? AND id = ?`, goalSelectFields)
+
+	goal, err := scanGoal(r.db.QueryRow(query, userID, goalID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("goal not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goal: %w", err)
+	}
+	return goal, nil
+}
+
+// ListByUser returns the user's goals, oldest first
+func (r *Repository) ListByUser(userID string) ([]models.ReadingGoal, error) {
+	query := fmt.Sprintf(`SELECT %s FROM reading_goals WHERE user_id = ? ORDER BY created_at ASC, rowid ASC`, goalSelectFields)
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+	defer rows.Close()
+
+	goals := []models.ReadingGoal{}
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan goal: %w", err)
+		}
+		goals = append(goals, *goal)
+	}
+
+	return goals, rows.Err()
+}
+
+// Update saves a goal's metric, genre, period and target. Changing a goal resets
+// its achieved period so that reaching the new target is reported again.
+func (r *Repository) Update(goal *models.ReadingGoal) error {
+	query := `
+		UPDATE reading_goals
+		SET metric = ?, genre = ?, period = ?, target = ?, achieved_period = '', updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND user_id = ?
+	`
+	result, err := r.db.Exec(query, goal.Metric, goal.Genre, goal.Period, goal.Target, goal.ID, goal.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+// Delete removes one of the user's goals
+func (r *Repository) Delete(userID, goalID string) error {
+	result, err := r.db.Exec(`DELETE FROM reading_goals WHERE id = ? AND user_id = ?`, goalID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+// MarkAchieved records the period in which a goal was reached. It returns false
+// when the goal was already marked for that period.
+func (r *Repository) MarkAchieved(goalID, periodKey string) (bool, error) {
+	result, err := r.db.Exec(`
+		UPDATE reading_goals SET achieved_period = ?
+		WHERE id = ? AND achieved_period != ?
+	`, periodKey, goalID, periodKey)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark goal achieved: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// Measure counts the goal's metric for the user between start (inclusive) and
+// end (exclusive), from the progress history. Only whole chapters count toward
+// chapter goals, so moving from 10 to 10.5 reads no new chapter.
+func (r *Repository) Measure(goal *models.ReadingGoal, start, end time.Time) (int, error) {
+	var query string
+	switch goal.Metric {
+	case models.GoalMetricCompletedSeries:
+		query = `
+			SELECT COUNT(DISTINCT ph.manga_id)
+			FROM progress_history ph
+			JOIN manga m ON m.id = ph.manga_id
+			WHERE ph.user_id = ? AND ph.created_at >= ? AND ph.created_at < ?
+				AND ph.status = 'completed'
+		`
+	default:
+		query = `
+			SELECT COALESCE(SUM(MAX(CAST(ph.to_chapter AS INTEGER) - CAST(ph.from_chapter AS INTEGER), 0)), 0)
+			FROM progress_history ph
+			JOIN manga m ON m.id = ph.manga_id
+			WHERE ph.user_id = ? AND ph.created_at >= ? AND ph.created_at < ?
+		`
+	}
+
+	// created_at holds CURRENT_TIMESTAMP values, which are UTC "YYYY-MM-DD HH:MM:SS" text
+	args := []interface{}{goal.UserID, start.UTC().Format("2006-01-02 15:04:05"), end.UTC().Format("2006-01-02 15:04:05")}
+
+	if goal.Genre != "" {
+		query += ` AND LOWER(m.genres) LIKE LOWER(?)`
+		args = append(args, `%"`+goal.Genre+`"%`)
+	}
+
+	var count int
+	if err := r.db.QueryRow(query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to measure goal: %w", err)
+	}
+	return count, nil
+}
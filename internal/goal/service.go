@@ -0,0 +1,175 @@
+package goal
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Service handles reading goal business logic
+type Service struct {
+	repo         *Repository
+	mangaService *manga.Service
+}
+
+// NewService creates a new goal service
+func NewService(repo *Repository, mangaService *manga.Service) *Service {
+	return &Service{repo: repo, mangaService: mangaService}
+}
+
+// List returns the user's goals with their progress in the current period
+func (s *Service) List(userID string) ([]models.GoalProgress, error) {
+	goals, err := s.repo.ListByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	progress := make([]models.GoalProgress, 0, len(goals))
+	for i := range goals {
+		p, err := s.progress(&goals[i], now)
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, *p)
+	}
+
+	return progress, nil
+}
+
+// Get returns one of the user's goals with its progress in the current period
+func (s *Service) Get(userID, goalID string) (*models.GoalProgress, error) {
+	goal, err := s.repo.FindByID(userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	return s.progress(goal, time.Now())
+}
+
+// Create creates a new reading goal
+func (s *Service) Create(userID string, req models.GoalCreateRequest) (*models.GoalProgress, error) {
+	goal := &models.ReadingGoal{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Metric: req.Metric,
+		Genre:  strings.TrimSpace(req.Genre),
+		Period: req.Period,
+		Target: req.Target,
+	}
+
+	if err := s.repo.Create(goal); err != nil {
+		return nil, err
+	}
+
+	return s.Get(userID, goal.ID)
+}
+
+// Update changes the metric, genre, period or target of a goal
+func (s *Service) Update(userID, goalID string, req models.GoalUpdateRequest) (*models.GoalProgress, error) {
+	goal, err := s.repo.FindByID(userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Metric != nil {
+		goal.Metric = *req.Metric
+	}
+	if req.Genre != nil {
+		goal.Genre = strings.TrimSpace(*req.Genre)
+	}
+	if req.Period != nil {
+		goal.Period = *req.Period
+	}
+	if req.Target != nil {
+		goal.Target = *req.Target
+	}
+
+	if err := s.repo.Update(goal); err != nil {
+		return nil, err
+	}
+
+	return s.Get(userID, goalID)
+}
+
+// Delete removes a goal
+func (s *Service) Delete(userID, goalID string) error {
+	return s.repo.Delete(userID, goalID)
+}
+
+// CheckGoals sends a notification for every goal the user reached in its
+// current period that has not been reported yet
+func (s *Service) CheckGoals(userID string) error {
+	goals, err := s.repo.ListByUser(userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range goals {
+		goal := &goals[i]
+		key := goal.Period.Key(now)
+		if goal.AchievedPeriod == key {
+			continue
+		}
+
+		p, err := s.progress(goal, now)
+		if err != nil {
+			return err
+		}
+		if !p.Achieved {
+			continue
+		}
+
+		marked, err := s.repo.MarkAchieved(goal.ID, key)
+		if err != nil {
+			return err
+		}
+		if marked {
+			s.mangaService.NotifyNotification(models.UDPNotification{
+				UserID:      userID,
+				ReleaseDate: now,
+				Message:     fmt.Sprintf("Goal reached: %s", describe(goal)),
+			})
+		}
+	}
+
+	return nil
+}
+
+// progress measures a goal over the period containing now
+func (s *Service) progress(goal *models.ReadingGoal, now time.Time) (*models.GoalProgress, error) {
+	start, end := goal.Period.Window(now)
+
+	current, err := s.repo.Measure(goal, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	percent := math.Min(float64(current)/float64(goal.Target)*100, 100)
+
+	return &models.GoalProgress{
+		ReadingGoal: *goal,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Current:     current,
+		Percent:     math.Round(percent*10) / 10,
+		Achieved:    current >= goal.Target,
+	}, nil
+}
+
+// describe formats a goal for notifications, e.g. "1000 chapters this year"
+func describe(goal *models.ReadingGoal) string {
+	what := "chapters"
+	if goal.Metric == models.GoalMetricCompletedSeries {
+		what = "completed series"
+	}
+	if goal.Genre != "" {
+		what = goal.Genre + " " + what
+	}
+	return fmt.Sprintf("%d %s this %s", goal.Target, what, goal.Period)
+}
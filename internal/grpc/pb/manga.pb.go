@@ -66,17 +66,18 @@ func (x *GetMangaRequest) GetMangaId() string {
 }
 
 type MangaResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Genres        []string               `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	TotalChapters int32                  `protobuf:"varint,6,opt,name=total_chapters,json=totalChapters,proto3" json:"total_chapters,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	CoverUrl      string                 `protobuf:"bytes,8,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author          string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Genres          []string               `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	TotalChapters   int32                  `protobuf:"varint,6,opt,name=total_chapters,json=totalChapters,proto3" json:"total_chapters,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CoverUrl        string                 `protobuf:"bytes,8,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	CommunityRating *CommunityRating       `protobuf:"bytes,9,opt,name=community_rating,json=communityRating,proto3" json:"community_rating,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MangaResponse) Reset() {
@@ -165,6 +166,81 @@ func (x *MangaResponse) GetCoverUrl() string {
 	return ""
 }
 
+func (x *MangaResponse) GetCommunityRating() *CommunityRating {
+	if x != nil {
+		return x.CommunityRating
+	}
+	return nil
+}
+
+type CommunityRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean          float64                `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Histogram     []int32                `protobuf:"varint,4,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityRating) Reset() {
+	*x = CommunityRating{}
+	mi := &file_manga_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityRating) ProtoMessage() {}
+
+func (x *CommunityRating) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityRating.ProtoReflect.Descriptor instead.
+func (*CommunityRating) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommunityRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CommunityRating) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *CommunityRating) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CommunityRating) GetHistogram() []int32 {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -180,7 +256,7 @@ type SearchRequest struct {
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_manga_proto_msgTypes[2]
+	mi := &file_manga_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -192,7 +268,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_manga_proto_msgTypes[2]
+	mi := &file_manga_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +281,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_manga_proto_rawDescGZIP(), []int{2}
+	return file_manga_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SearchRequest) GetTitle() string {
@@ -267,7 +343,7 @@ type SearchResponse struct {
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
-	mi := &file_manga_proto_msgTypes[3]
+	mi := &file_manga_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +355,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_manga_proto_msgTypes[3]
+	mi := &file_manga_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +368,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_manga_proto_rawDescGZIP(), []int{3}
+	return file_manga_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchResponse) GetManga() []*MangaResponse {
@@ -313,19 +389,20 @@ type UserProgress struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	MangaId        string                 `protobuf:"bytes,2,opt,name=manga_id,json=mangaId,proto3" json:"manga_id,omitempty"`
-	CurrentChapter int32                  `protobuf:"varint,3,opt,name=current_chapter,json=currentChapter,proto3" json:"current_chapter,omitempty"`
 	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	Rating         int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
 	StartedAt      string                 `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 	CompletedAt    string                 `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	UpdatedAt      string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CurrentChapter float64                `protobuf:"fixed64,9,opt,name=current_chapter,json=currentChapter,proto3" json:"current_chapter,omitempty"`
+	Version        int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UserProgress) Reset() {
 	*x = UserProgress{}
-	mi := &file_manga_proto_msgTypes[4]
+	mi := &file_manga_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +414,7 @@ func (x *UserProgress) String() string {
 func (*UserProgress) ProtoMessage() {}
 
 func (x *UserProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_manga_proto_msgTypes[4]
+	mi := &file_manga_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +427,7 @@ func (x *UserProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProgress.ProtoReflect.Descriptor instead.
 func (*UserProgress) Descriptor() ([]byte, []int) {
-	return file_manga_proto_rawDescGZIP(), []int{4}
+	return file_manga_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserProgress) GetUserId() string {
@@ -367,13 +444,6 @@ func (x *UserProgress) GetMangaId() string {
 	return ""
 }
 
-func (x *UserProgress) GetCurrentChapter() int32 {
-	if x != nil {
-		return x.CurrentChapter
-	}
-	return 0
-}
-
 func (x *UserProgress) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -409,20 +479,36 @@ func (x *UserProgress) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *UserProgress) GetCurrentChapter() float64 {
+	if x != nil {
+		return x.CurrentChapter
+	}
+	return 0
+}
+
+func (x *UserProgress) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateProgressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	MangaId       string                 `protobuf:"bytes,2,opt,name=manga_id,json=mangaId,proto3" json:"manga_id,omitempty"`
 	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Chapter       int32                  `protobuf:"varint,4,opt,name=chapter,proto3" json:"chapter,omitempty"`
 	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Chapter       *float64               `protobuf:"fixed64,6,opt,name=chapter,proto3,oneof" json:"chapter,omitempty"`
+	Version       *int32                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	OnConflict    string                 `protobuf:"bytes,8,opt,name=on_conflict,json=onConflict,proto3" json:"on_conflict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProgressRequest) Reset() {
 	*x = UpdateProgressRequest{}
-	mi := &file_manga_proto_msgTypes[5]
+	mi := &file_manga_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +520,7 @@ func (x *UpdateProgressRequest) String() string {
 func (*UpdateProgressRequest) ProtoMessage() {}
 
 func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_manga_proto_msgTypes[5]
+	mi := &file_manga_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +533,7 @@ func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProgressRequest) Descriptor() ([]byte, []int) {
-	return file_manga_proto_rawDescGZIP(), []int{5}
+	return file_manga_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateProgressRequest) GetUserId() string {
@@ -471,20 +557,34 @@ func (x *UpdateProgressRequest) GetStatus() string {
 	return ""
 }
 
-func (x *UpdateProgressRequest) GetChapter() int32 {
+func (x *UpdateProgressRequest) GetRating() int32 {
 	if x != nil {
-		return x.Chapter
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *UpdateProgressRequest) GetRating() int32 {
-	if x != nil {
-		return x.Rating
+func (x *UpdateProgressRequest) GetChapter() float64 {
+	if x != nil && x.Chapter != nil {
+		return *x.Chapter
 	}
 	return 0
 }
 
+func (x *UpdateProgressRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateProgressRequest) GetOnConflict() string {
+	if x != nil {
+		return x.OnConflict
+	}
+	return ""
+}
+
 type UpdateProgressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Progress      *UserProgress          `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
@@ -494,7 +594,7 @@ type UpdateProgressResponse struct {
 
 func (x *UpdateProgressResponse) Reset() {
 	*x = UpdateProgressResponse{}
-	mi := &file_manga_proto_msgTypes[6]
+	mi := &file_manga_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +606,7 @@ func (x *UpdateProgressResponse) String() string {
 func (*UpdateProgressResponse) ProtoMessage() {}
 
 func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_manga_proto_msgTypes[6]
+	mi := &file_manga_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +619,7 @@ func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgressResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProgressResponse) Descriptor() ([]byte, []int) {
-	return file_manga_proto_rawDescGZIP(), []int{6}
+	return file_manga_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProgressResponse) GetProgress() *UserProgress {
@@ -529,13 +629,393 @@ func (x *UpdateProgressResponse) GetProgress() *UserProgress {
 	return nil
 }
 
+type CatalogChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Follow        bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogChangesRequest) Reset() {
+	*x = CatalogChangesRequest{}
+	mi := &file_manga_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogChangesRequest) ProtoMessage() {}
+
+func (x *CatalogChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogChangesRequest.ProtoReflect.Descriptor instead.
+func (*CatalogChangesRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CatalogChangesRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *CatalogChangesRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type CatalogChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	MangaId       string                 `protobuf:"bytes,3,opt,name=manga_id,json=mangaId,proto3" json:"manga_id,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Manga         *MangaResponse         `protobuf:"bytes,5,opt,name=manga,proto3" json:"manga,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogChange) Reset() {
+	*x = CatalogChange{}
+	mi := &file_manga_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogChange) ProtoMessage() {}
+
+func (x *CatalogChange) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogChange.ProtoReflect.Descriptor instead.
+func (*CatalogChange) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CatalogChange) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetMangaId() string {
+	if x != nil {
+		return x.MangaId
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetManga() *MangaResponse {
+	if x != nil {
+		return x.Manga
+	}
+	return nil
+}
+
+type LibraryStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibraryStatsRequest) Reset() {
+	*x = LibraryStatsRequest{}
+	mi := &file_manga_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryStatsRequest) ProtoMessage() {}
+
+func (x *LibraryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryStatsRequest.ProtoReflect.Descriptor instead.
+func (*LibraryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LibraryStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CompositionGroup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Titles         int32                  `protobuf:"varint,2,opt,name=titles,proto3" json:"titles,omitempty"`
+	Started        int32                  `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Completed      int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Dropped        int32                  `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	ChaptersRead   int32                  `protobuf:"varint,6,opt,name=chapters_read,json=chaptersRead,proto3" json:"chapters_read,omitempty"`
+	Rated          int32                  `protobuf:"varint,7,opt,name=rated,proto3" json:"rated,omitempty"`
+	AverageRating  *float64               `protobuf:"fixed64,8,opt,name=average_rating,json=averageRating,proto3,oneof" json:"average_rating,omitempty"`
+	CompletionRate *float64               `protobuf:"fixed64,9,opt,name=completion_rate,json=completionRate,proto3,oneof" json:"completion_rate,omitempty"`
+	DroppedRate    *float64               `protobuf:"fixed64,10,opt,name=dropped_rate,json=droppedRate,proto3,oneof" json:"dropped_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompositionGroup) Reset() {
+	*x = CompositionGroup{}
+	mi := &file_manga_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositionGroup) ProtoMessage() {}
+
+func (x *CompositionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositionGroup.ProtoReflect.Descriptor instead.
+func (*CompositionGroup) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompositionGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompositionGroup) GetTitles() int32 {
+	if x != nil {
+		return x.Titles
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetStarted() int32 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetChaptersRead() int32 {
+	if x != nil {
+		return x.ChaptersRead
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetRated() int32 {
+	if x != nil {
+		return x.Rated
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetAverageRating() float64 {
+	if x != nil && x.AverageRating != nil {
+		return *x.AverageRating
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetCompletionRate() float64 {
+	if x != nil && x.CompletionRate != nil {
+		return *x.CompletionRate
+	}
+	return 0
+}
+
+func (x *CompositionGroup) GetDroppedRate() float64 {
+	if x != nil && x.DroppedRate != nil {
+		return *x.DroppedRate
+	}
+	return 0
+}
+
+type LibraryStatsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Titles              int32                  `protobuf:"varint,1,opt,name=titles,proto3" json:"titles,omitempty"`
+	Genres              []*CompositionGroup    `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Authors             []*CompositionGroup    `protobuf:"bytes,3,rep,name=authors,proto3" json:"authors,omitempty"`
+	PublicationStatuses []*CompositionGroup    `protobuf:"bytes,4,rep,name=publication_statuses,json=publicationStatuses,proto3" json:"publication_statuses,omitempty"`
+	RatingHistogram     []int32                `protobuf:"varint,5,rep,packed,name=rating_histogram,json=ratingHistogram,proto3" json:"rating_histogram,omitempty"`
+	Rated               int32                  `protobuf:"varint,6,opt,name=rated,proto3" json:"rated,omitempty"`
+	AverageRating       *float64               `protobuf:"fixed64,7,opt,name=average_rating,json=averageRating,proto3,oneof" json:"average_rating,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LibraryStatsResponse) Reset() {
+	*x = LibraryStatsResponse{}
+	mi := &file_manga_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryStatsResponse) ProtoMessage() {}
+
+func (x *LibraryStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryStatsResponse.ProtoReflect.Descriptor instead.
+func (*LibraryStatsResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LibraryStatsResponse) GetTitles() int32 {
+	if x != nil {
+		return x.Titles
+	}
+	return 0
+}
+
+func (x *LibraryStatsResponse) GetGenres() []*CompositionGroup {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *LibraryStatsResponse) GetAuthors() []*CompositionGroup {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *LibraryStatsResponse) GetPublicationStatuses() []*CompositionGroup {
+	if x != nil {
+		return x.PublicationStatuses
+	}
+	return nil
+}
+
+func (x *LibraryStatsResponse) GetRatingHistogram() []int32 {
+	if x != nil {
+		return x.RatingHistogram
+	}
+	return nil
+}
+
+func (x *LibraryStatsResponse) GetRated() int32 {
+	if x != nil {
+		return x.Rated
+	}
+	return 0
+}
+
+func (x *LibraryStatsResponse) GetAverageRating() float64 {
+	if x != nil && x.AverageRating != nil {
+		return *x.AverageRating
+	}
+	return 0
+}
+
 var File_manga_proto protoreflect.FileDescriptor
 
 const file_manga_proto_rawDesc = "" +
 	"\n" +
 	"\vmanga.proto\x12\x05manga\",\n" +
 	"\x0fGetMangaRequest\x12\x19\n" +
-	"\bmanga_id\x18\x01 \x01(\tR\amangaId\"\xe3\x01\n" +
+	"\bmanga_id\x18\x01 \x01(\tR\amangaId\"\xa6\x02\n" +
 	"\rMangaResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
@@ -544,7 +1024,13 @@ const file_manga_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
 	"\x0etotal_chapters\x18\x06 \x01(\x05R\rtotalChapters\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1b\n" +
-	"\tcover_url\x18\b \x01(\tR\bcoverUrl\"\xb4\x01\n" +
+	"\tcover_url\x18\b \x01(\tR\bcoverUrl\x12A\n" +
+	"\x10community_rating\x18\t \x01(\v2\x16.manga.CommunityRatingR\x0fcommunityRating\"o\n" +
+	"\x0fCommunityRating\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04mean\x18\x02 \x01(\x01R\x04mean\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x1c\n" +
+	"\thistogram\x18\x04 \x03(\x05R\thistogram\"\xb4\x01\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
 	"\x06author\x18\x02 \x01(\tR\x06author\x12\x14\n" +
@@ -555,30 +1041,77 @@ const file_manga_proto_rawDesc = "" +
 	"\x06offset\x18\a \x01(\x05R\x06offset\"R\n" +
 	"\x0eSearchResponse\x12*\n" +
 	"\x05manga\x18\x01 \x03(\v2\x14.manga.MangaResponseR\x05manga\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xfc\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9c\x02\n" +
 	"\fUserProgress\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bmanga_id\x18\x02 \x01(\tR\amangaId\x12'\n" +
-	"\x0fcurrent_chapter\x18\x03 \x01(\x05R\x0ecurrentChapter\x12\x16\n" +
+	"\bmanga_id\x18\x02 \x01(\tR\amangaId\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
 	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x1d\n" +
 	"\n" +
 	"started_at\x18\x06 \x01(\tR\tstartedAt\x12!\n" +
 	"\fcompleted_at\x18\a \x01(\tR\vcompletedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x95\x01\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12'\n" +
+	"\x0fcurrent_chapter\x18\t \x01(\x01R\x0ecurrentChapter\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversionJ\x04\b\x03\x10\x04\"\xf8\x01\n" +
 	"\x15UpdateProgressRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bmanga_id\x18\x02 \x01(\tR\amangaId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
-	"\achapter\x18\x04 \x01(\x05R\achapter\x12\x16\n" +
-	"\x06rating\x18\x05 \x01(\x05R\x06rating\"I\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x1d\n" +
+	"\achapter\x18\x06 \x01(\x01H\x00R\achapter\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x05H\x01R\aversion\x88\x01\x01\x12\x1f\n" +
+	"\von_conflict\x18\b \x01(\tR\n" +
+	"onConflictB\n" +
+	"\n" +
+	"\b_chapterB\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x04\x10\x05\"I\n" +
 	"\x16UpdateProgressResponse\x12/\n" +
-	"\bprogress\x18\x01 \x01(\v2\x13.manga.UserProgressR\bprogress2\xd3\x01\n" +
+	"\bprogress\x18\x01 \x01(\v2\x13.manga.UserProgressR\bprogress\"E\n" +
+	"\x15CatalogChangesRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\"\x9f\x01\n" +
+	"\rCatalogChange\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\bmanga_id\x18\x03 \x01(\tR\amangaId\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\tR\tchangedAt\x12*\n" +
+	"\x05manga\x18\x05 \x01(\v2\x14.manga.MangaResponseR\x05manga\".\n" +
+	"\x13LibraryStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x85\x03\n" +
+	"\x10CompositionGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06titles\x18\x02 \x01(\x05R\x06titles\x12\x18\n" +
+	"\astarted\x18\x03 \x01(\x05R\astarted\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x05R\tcompleted\x12\x18\n" +
+	"\adropped\x18\x05 \x01(\x05R\adropped\x12#\n" +
+	"\rchapters_read\x18\x06 \x01(\x05R\fchaptersRead\x12\x14\n" +
+	"\x05rated\x18\a \x01(\x05R\x05rated\x12*\n" +
+	"\x0eaverage_rating\x18\b \x01(\x01H\x00R\raverageRating\x88\x01\x01\x12,\n" +
+	"\x0fcompletion_rate\x18\t \x01(\x01H\x01R\x0ecompletionRate\x88\x01\x01\x12&\n" +
+	"\fdropped_rate\x18\n" +
+	" \x01(\x01H\x02R\vdroppedRate\x88\x01\x01B\x11\n" +
+	"\x0f_average_ratingB\x12\n" +
+	"\x10_completion_rateB\x0f\n" +
+	"\r_dropped_rate\"\xde\x02\n" +
+	"\x14LibraryStatsResponse\x12\x16\n" +
+	"\x06titles\x18\x01 \x01(\x05R\x06titles\x12/\n" +
+	"\x06genres\x18\x02 \x03(\v2\x17.manga.CompositionGroupR\x06genres\x121\n" +
+	"\aauthors\x18\x03 \x03(\v2\x17.manga.CompositionGroupR\aauthors\x12J\n" +
+	"\x14publication_statuses\x18\x04 \x03(\v2\x17.manga.CompositionGroupR\x13publicationStatuses\x12)\n" +
+	"\x10rating_histogram\x18\x05 \x03(\x05R\x0fratingHistogram\x12\x14\n" +
+	"\x05rated\x18\x06 \x01(\x05R\x05rated\x12*\n" +
+	"\x0eaverage_rating\x18\a \x01(\x01H\x00R\raverageRating\x88\x01\x01B\x11\n" +
+	"\x0f_average_rating2\xed\x02\n" +
 	"\fMangaService\x128\n" +
 	"\bGetManga\x12\x16.manga.GetMangaRequest\x1a\x14.manga.MangaResponse\x12:\n" +
 	"\vSearchManga\x12\x14.manga.SearchRequest\x1a\x15.manga.SearchResponse\x12M\n" +
-	"\x0eUpdateProgress\x12\x1c.manga.UpdateProgressRequest\x1a\x1d.manga.UpdateProgressResponseB\x1bZ\x19mangahub/internal/grpc/pbb\x06proto3"
+	"\x0eUpdateProgress\x12\x1c.manga.UpdateProgressRequest\x1a\x1d.manga.UpdateProgressResponse\x12L\n" +
+	"\x14StreamCatalogChanges\x12\x1c.manga.CatalogChangesRequest\x1a\x14.manga.CatalogChange0\x01\x12J\n" +
+	"\x0fGetLibraryStats\x12\x1a.manga.LibraryStatsRequest\x1a\x1b.manga.LibraryStatsResponseB\x1bZ\x19mangahub/internal/grpc/pbb\x06proto3"
 
 var (
 	file_manga_proto_rawDescOnce sync.Once
@@ -592,30 +1125,45 @@ func file_manga_proto_rawDescGZIP() []byte {
 	return file_manga_proto_rawDescData
 }
 
-var file_manga_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_manga_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_manga_proto_goTypes = []any{
 	(*GetMangaRequest)(nil),        // 0: manga.GetMangaRequest
 	(*MangaResponse)(nil),          // 1: manga.MangaResponse
-	(*SearchRequest)(nil),          // 2: manga.SearchRequest
-	(*SearchResponse)(nil),         // 3: manga.SearchResponse
-	(*UserProgress)(nil),           // 4: manga.UserProgress
-	(*UpdateProgressRequest)(nil),  // 5: manga.UpdateProgressRequest
-	(*UpdateProgressResponse)(nil), // 6: manga.UpdateProgressResponse
+	(*CommunityRating)(nil),        // 2: manga.CommunityRating
+	(*SearchRequest)(nil),          // 3: manga.SearchRequest
+	(*SearchResponse)(nil),         // 4: manga.SearchResponse
+	(*UserProgress)(nil),           // 5: manga.UserProgress
+	(*UpdateProgressRequest)(nil),  // 6: manga.UpdateProgressRequest
+	(*UpdateProgressResponse)(nil), // 7: manga.UpdateProgressResponse
+	(*CatalogChangesRequest)(nil),  // 8: manga.CatalogChangesRequest
+	(*CatalogChange)(nil),          // 9: manga.CatalogChange
+	(*LibraryStatsRequest)(nil),    // 10: manga.LibraryStatsRequest
+	(*CompositionGroup)(nil),       // 11: manga.CompositionGroup
+	(*LibraryStatsResponse)(nil),   // 12: manga.LibraryStatsResponse
 }
 var file_manga_proto_depIdxs = []int32{
-	1, // 0: manga.SearchResponse.manga:type_name -> manga.MangaResponse
-	4, // 1: manga.UpdateProgressResponse.progress:type_name -> manga.UserProgress
-	0, // 2: manga.MangaService.GetManga:input_type -> manga.GetMangaRequest
-	2, // 3: manga.MangaService.SearchManga:input_type -> manga.SearchRequest
-	5, // 4: manga.MangaService.UpdateProgress:input_type -> manga.UpdateProgressRequest
-	1, // 5: manga.MangaService.GetManga:output_type -> manga.MangaResponse
-	3, // 6: manga.MangaService.SearchManga:output_type -> manga.SearchResponse
-	6, // 7: manga.MangaService.UpdateProgress:output_type -> manga.UpdateProgressResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: manga.MangaResponse.community_rating:type_name -> manga.CommunityRating
+	1,  // 1: manga.SearchResponse.manga:type_name -> manga.MangaResponse
+	5,  // 2: manga.UpdateProgressResponse.progress:type_name -> manga.UserProgress
+	1,  // 3: manga.CatalogChange.manga:type_name -> manga.MangaResponse
+	11, // 4: manga.LibraryStatsResponse.genres:type_name -> manga.CompositionGroup
+	11, // 5: manga.LibraryStatsResponse.authors:type_name -> manga.CompositionGroup
+	11, // 6: manga.LibraryStatsResponse.publication_statuses:type_name -> manga.CompositionGroup
+	0,  // 7: manga.MangaService.GetManga:input_type -> manga.GetMangaRequest
+	3,  // 8: manga.MangaService.SearchManga:input_type -> manga.SearchRequest
+	6,  // 9: manga.MangaService.UpdateProgress:input_type -> manga.UpdateProgressRequest
+	8,  // 10: manga.MangaService.StreamCatalogChanges:input_type -> manga.CatalogChangesRequest
+	10, // 11: manga.MangaService.GetLibraryStats:input_type -> manga.LibraryStatsRequest
+	1,  // 12: manga.MangaService.GetManga:output_type -> manga.MangaResponse
+	4,  // 13: manga.MangaService.SearchManga:output_type -> manga.SearchResponse
+	7,  // 14: manga.MangaService.UpdateProgress:output_type -> manga.UpdateProgressResponse
+	9,  // 15: manga.MangaService.StreamCatalogChanges:output_type -> manga.CatalogChange
+	12, // 16: manga.MangaService.GetLibraryStats:output_type -> manga.LibraryStatsResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_manga_proto_init() }
@@ -623,13 +1171,16 @@ func file_manga_proto_init() {
 	if File_manga_proto != nil {
 		return
 	}
+	file_manga_proto_msgTypes[6].OneofWrappers = []any{}
+	file_manga_proto_msgTypes[11].OneofWrappers = []any{}
+	file_manga_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_manga_proto_rawDesc), len(file_manga_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MangaService_GetManga_FullMethodName       = "/manga.MangaService/GetManga"
-	MangaService_SearchManga_FullMethodName    = "/manga.MangaService/SearchManga"
-	MangaService_UpdateProgress_FullMethodName = "/manga.MangaService/UpdateProgress"
+	MangaService_GetManga_FullMethodName             = "/manga.MangaService/GetManga"
+	MangaService_SearchManga_FullMethodName          = "/manga.MangaService/SearchManga"
+	MangaService_UpdateProgress_FullMethodName       = "/manga.MangaService/UpdateProgress"
+	MangaService_StreamCatalogChanges_FullMethodName = "/manga.MangaService/StreamCatalogChanges"
+	MangaService_GetLibraryStats_FullMethodName      = "/manga.MangaService/GetLibraryStats"
 )
 
 // MangaServiceClient is the client API for MangaService service.
@@ -31,6 +33,8 @@ type MangaServiceClient interface {
 	GetManga(ctx context.Context, in *GetMangaRequest, opts ...grpc.CallOption) (*MangaResponse, error)
 	SearchManga(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*UpdateProgressResponse, error)
+	StreamCatalogChanges(ctx context.Context, in *CatalogChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogChange], error)
+	GetLibraryStats(ctx context.Context, in *LibraryStatsRequest, opts ...grpc.CallOption) (*LibraryStatsResponse, error)
 }
 
 type mangaServiceClient struct {
@@ -71,6 +75,35 @@ func (c *mangaServiceClient) UpdateProgress(ctx context.Context, in *UpdateProgr
 	return out, nil
 }
 
+func (c *mangaServiceClient) StreamCatalogChanges(ctx context.Context, in *CatalogChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MangaService_ServiceDesc.Streams[0], MangaService_StreamCatalogChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CatalogChangesRequest, CatalogChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MangaService_StreamCatalogChangesClient = grpc.ServerStreamingClient[CatalogChange]
+
+func (c *mangaServiceClient) GetLibraryStats(ctx context.Context, in *LibraryStatsRequest, opts ...grpc.CallOption) (*LibraryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LibraryStatsResponse)
+	err := c.cc.Invoke(ctx, MangaService_GetLibraryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MangaServiceServer is the server API for MangaService service.
 // All implementations must embed UnimplementedMangaServiceServer
 // for forward compatibility.
@@ -78,6 +111,8 @@ type MangaServiceServer interface {
 	GetManga(context.Context, *GetMangaRequest) (*MangaResponse, error)
 	SearchManga(context.Context, *SearchRequest) (*SearchResponse, error)
 	UpdateProgress(context.Context, *UpdateProgressRequest) (*UpdateProgressResponse, error)
+	StreamCatalogChanges(*CatalogChangesRequest, grpc.ServerStreamingServer[CatalogChange]) error
+	GetLibraryStats(context.Context, *LibraryStatsRequest) (*LibraryStatsResponse, error)
 	mustEmbedUnimplementedMangaServiceServer()
 }
 
@@ -97,6 +132,12 @@ func (UnimplementedMangaServiceServer) SearchManga(context.Context, *SearchReque
 func (UnimplementedMangaServiceServer) UpdateProgress(context.Context, *UpdateProgressRequest) (*UpdateProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgress not implemented")
 }
+func (UnimplementedMangaServiceServer) StreamCatalogChanges(*CatalogChangesRequest, grpc.ServerStreamingServer[CatalogChange]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCatalogChanges not implemented")
+}
+func (UnimplementedMangaServiceServer) GetLibraryStats(context.Context, *LibraryStatsRequest) (*LibraryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLibraryStats not implemented")
+}
 func (UnimplementedMangaServiceServer) mustEmbedUnimplementedMangaServiceServer() {}
 func (UnimplementedMangaServiceServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +213,35 @@ func _MangaService_UpdateProgress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MangaService_StreamCatalogChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CatalogChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MangaServiceServer).StreamCatalogChanges(m, &grpc.GenericServerStream[CatalogChangesRequest, CatalogChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MangaService_StreamCatalogChangesServer = grpc.ServerStreamingServer[CatalogChange]
+
+func _MangaService_GetLibraryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServiceServer).GetLibraryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MangaService_GetLibraryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServiceServer).GetLibraryStats(ctx, req.(*LibraryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MangaService_ServiceDesc is the grpc.ServiceDesc for MangaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,7 +261,17 @@ var MangaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProgress",
 			Handler:    _MangaService_UpdateProgress_Handler,
 		},
+		{
+			MethodName: "GetLibraryStats",
+			Handler:    _MangaService_GetLibraryStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCatalogChanges",
+			Handler:       _MangaService_StreamCatalogChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "manga.proto",
 }
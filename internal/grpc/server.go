@@ -2,39 +2,93 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tnphucccc/mangahub/internal/grpc/pb"
 	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/user"
 	"github.com/tnphucccc/mangahub/pkg/models"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 // Server implements the gRPC MangaServiceServer interface.
 type Server struct {
 	pb.UnimplementedMangaServiceServer
-	mangaService *manga.Service
+	mangaService   *manga.Service
+	statsService   *stats.Service
+	userService    *user.Service
+	profileService *profile.Service
 }
 
 // NewServer creates a new gRPC server.
-func NewServer(mangaService *manga.Service) *Server {
+func NewServer(mangaService *manga.Service, statsService *stats.Service, userService *user.Service, profileService *profile.Service) *Server {
 	return &Server{
-		mangaService: mangaService,
+		mangaService:   mangaService,
+		statsService:   statsService,
+		userService:    userService,
+		profileService: profileService,
 	}
 }
 
+// caller returns the user whose token came in the "authorization" metadata
+// ("Bearer <token>"), or nil when the request carries none
+func (s *Server) caller(ctx context.Context) (*models.User, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, nil
+	}
+
+	token, ok := strings.CutPrefix(md.Get("authorization")[0], "Bearer ")
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	u, err := s.userService.ValidateToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired token")
+	}
+	return u, nil
+}
+
 // Convert response into MangaResponse
 func toMangaResponse(m *models.Manga) *pb.MangaResponse {
 	return &pb.MangaResponse{
-		Id:            m.ID,
-		Title:         m.Title,
-		Author:        m.Author,
-		Genres:        m.Genres,
-		Status:        string(m.Status),
-		TotalChapters: int32(m.TotalChapters),
-		Description:   m.Description,
-		CoverUrl:      m.CoverImageURL,
+		Id:              m.ID,
+		Title:           m.Title,
+		Author:          m.Author,
+		Genres:          m.Genres,
+		Status:          string(m.Status),
+		TotalChapters:   int32(m.TotalChapters),
+		Description:     m.Description,
+		CoverUrl:        m.CoverImageURL,
+		CommunityRating: toCommunityRating(m.CommunityRating),
+	}
+}
+
+// Convert a manga's rating aggregate, leaving it unset for unrated manga
+func toCommunityRating(r *models.CommunityRating) *pb.CommunityRating {
+	if r == nil {
+		return nil
+	}
+
+	histogram := make([]int32, len(r.Histogram))
+	for i, n := range r.Histogram {
+		histogram[i] = int32(n)
+	}
+
+	return &pb.CommunityRating{
+		Count:     int32(r.Count),
+		Mean:      r.Mean,
+		Score:     r.Score,
+		Histogram: histogram,
 	}
 }
 
@@ -58,6 +112,7 @@ func (s *Server) SearchManga(ctx context.Context, req *pb.SearchRequest) (*pb.Se
 		Author: req.GetAuthor(),
 		Genre:  req.GetGenre(),
 		Status: models.MangaStatus(req.GetStatus()),
+		Sort:   req.GetOrderBy(),
 		Limit:  int(req.GetLimit()),
 		Offset: int(req.GetOffset()),
 	}
@@ -77,20 +132,107 @@ func (s *Server) SearchManga(ctx context.Context, req *pb.SearchRequest) (*pb.Se
 	}, nil
 }
 
+// catalogChangesPollInterval is how often a following stream checks for new changes
+const catalogChangesPollInterval = 2 * time.Second
+
+// StreamCatalogChanges streams catalog changes after req.Since in order. With
+// follow set, the stream stays open and delivers new changes until cancelled.
+func (s *Server) StreamCatalogChanges(req *pb.CatalogChangesRequest, stream grpc.ServerStreamingServer[pb.CatalogChange]) error {
+	since := req.GetSince()
+	for {
+		page, err := s.mangaService.GetChanges(models.MangaChangesQuery{Since: since, Limit: models.MaxMangaChangesLimit})
+		if err != nil {
+			if err.Error() == "invalid change token" {
+				return status.Errorf(codes.InvalidArgument, "invalid change token: %q", since)
+			}
+			return status.Errorf(codes.Internal, "Failed to get catalog changes: %v", err)
+		}
+
+		for i := range page.Changes {
+			if err := stream.Send(toCatalogChange(&page.Changes[i])); err != nil {
+				return err
+			}
+		}
+		since = page.NextSince
+
+		if page.HasMore {
+			continue
+		}
+		if !req.GetFollow() {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-time.After(catalogChangesPollInterval):
+		}
+	}
+}
+
+// Convert a change feed entry into its protobuf message
+func toCatalogChange(change *models.MangaChange) *pb.CatalogChange {
+	msg := &pb.CatalogChange{
+		Token:     change.Token,
+		Type:      change.Type,
+		MangaId:   change.MangaID,
+		ChangedAt: strconv.FormatInt(change.ChangedAt.Unix(), 10),
+	}
+	if change.Manga != nil {
+		msg.Manga = toMangaResponse(change.Manga)
+	}
+	return msg
+}
+
 // UpdateProgress updates the user's reading progress for a manga.
 func (s *Server) UpdateProgress(ctx context.Context, req *pb.UpdateProgressRequest) (*pb.UpdateProgressResponse, error) {
-	statusVal := models.ReadingStatus(req.GetStatus())
-	ratingVal := int(req.GetRating())
-	chapterVal := int(req.GetChapter())
+	request := models.ProgressUpdateRequest{}
 
-	request := models.ProgressUpdateRequest{
-		Status:         &statusVal,
-		Rating:         &ratingVal,
-		CurrentChapter: &chapterVal,
+	// An unset chapter, empty status and zero rating mean "leave unchanged"
+	if req.Chapter != nil {
+		chapterVal, err := models.NewChapterNumber(req.GetChapter())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		request.CurrentChapter = &chapterVal
+	}
+	if req.GetStatus() != "" {
+		statusVal := models.ReadingStatus(req.GetStatus())
+		request.Status = &statusVal
+	}
+	if req.GetRating() != 0 {
+		ratingVal := int(req.GetRating())
+		request.Rating = &ratingVal
+	}
+	if req.Version != nil {
+		versionVal := int(req.GetVersion())
+		request.Version = &versionVal
+	}
+	switch req.GetOnConflict() {
+	case "", models.ConflictPolicyReject, models.ConflictPolicyHighestChapter:
+		request.OnConflict = req.GetOnConflict()
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid on_conflict: %q", req.GetOnConflict())
 	}
 
 	err := s.mangaService.UpdateProgress(req.GetUserId(), req.GetMangaId(), request)
 	if err != nil {
+		var transitionErr *manga.TransitionError
+		if errors.As(err, &transitionErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %s", transitionErr.Code, transitionErr.Message)
+		}
+
+		// A stale update is aborted with the current progress attached as a detail
+		if errors.Is(err, manga.ErrProgressConflict) {
+			st := status.New(codes.Aborted, fmt.Sprintf("%s: %s", manga.ErrCodeVersionConflict, err.Error()))
+			var conflictErr *manga.ConflictError
+			if errors.As(err, &conflictErr) {
+				if detailed, err := st.WithDetails(toUserProgress(conflictErr.Current)); err == nil {
+					st = detailed
+				}
+			}
+			return nil, st.Err()
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to update progress: %v", err)
 	}
 
@@ -99,18 +241,90 @@ func (s *Server) UpdateProgress(ctx context.Context, req *pb.UpdateProgressReque
 		return nil, status.Errorf(codes.Internal, "Failed to retrieve updated progress: %v", err)
 	}
 
-	progressResponse := &pb.UserProgress{
+	return &pb.UpdateProgressResponse{
+		Progress: toUserProgress(progress),
+	}, nil
+}
+
+// Convert progress into its protobuf message
+func toUserProgress(progress *models.UserProgress) *pb.UserProgress {
+	return &pb.UserProgress{
 		UserId:         progress.UserID,
 		MangaId:        progress.MangaID,
-		CurrentChapter: int32(progress.CurrentChapter),
+		CurrentChapter: progress.CurrentChapter.Float64(),
 		Status:         string(progress.Status),
 		Rating:         int32(progress.GetRatingValue()),
 		StartedAt:      strconv.FormatInt(progress.GetStartedAtValue().Unix(), 10),
 		CompletedAt:    strconv.FormatInt(progress.GetCompletedAtValue().Unix(), 10),
 		UpdatedAt:      strconv.FormatInt(progress.UpdatedAt.Unix(), 10),
+		Version:        int32(progress.Version),
+	}
+}
+
+// GetLibraryStats breaks a user's library down by genre, author, publication
+// status and rating. The caller must be allowed to see the user's library, as
+// on their public profile; hidden entries only count for the owner.
+func (s *Server) GetLibraryStats(ctx context.Context, req *pb.LibraryStatsRequest) (*pb.LibraryStatsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
 	}
 
-	return &pb.UpdateProgressResponse{
-		Progress: progressResponse,
+	owner, err := s.userService.GetByID(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "User with ID %s not found", req.GetUserId())
+	}
+	viewer, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Library statistics follow the owner's profile privacy settings
+	allowed, err := s.profileService.CanView(viewer, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to check profile access: %v", err)
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "library is private")
+	}
+
+	own := viewer != nil && viewer.ID == owner.ID
+	composition, err := s.statsService.GetComposition(owner.ID, own)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get library stats: %v", err)
+	}
+
+	histogram := make([]int32, len(composition.Ratings.Counts))
+	for i, n := range composition.Ratings.Counts {
+		histogram[i] = int32(n)
+	}
+
+	return &pb.LibraryStatsResponse{
+		Titles:              int32(composition.Titles),
+		Genres:              toCompositionGroups(composition.Genres),
+		Authors:             toCompositionGroups(composition.Authors),
+		PublicationStatuses: toCompositionGroups(composition.PublicationStatuses),
+		RatingHistogram:     histogram,
+		Rated:               int32(composition.Ratings.Rated),
+		AverageRating:       composition.Ratings.AverageRating,
 	}, nil
 }
+
+// Convert composition groups into their protobuf messages
+func toCompositionGroups(groups []models.CompositionGroup) []*pb.CompositionGroup {
+	messages := make([]*pb.CompositionGroup, 0, len(groups))
+	for _, group := range groups {
+		messages = append(messages, &pb.CompositionGroup{
+			Name:           group.Name,
+			Titles:         int32(group.Titles),
+			Started:        int32(group.Started),
+			Completed:      int32(group.Completed),
+			Dropped:        int32(group.Dropped),
+			ChaptersRead:   int32(group.ChaptersRead),
+			Rated:          int32(group.Rated),
+			AverageRating:  group.AverageRating,
+			CompletionRate: group.CompletionRate,
+			DroppedRate:    group.DroppedRate,
+		})
+	}
+	return messages
+}
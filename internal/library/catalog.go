@@ -0,0 +1,71 @@
+package library
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// catalogIndex matches imported titles against catalog titles and aliases
+type catalogIndex struct {
+	manga  map[string]models.Manga
+	byName map[string][]string // normalized title -> manga IDs
+}
+
+func newCatalogIndex(mangaList []models.Manga, aliases map[string][]string) *catalogIndex {
+	index := &catalogIndex{
+		manga:  make(map[string]models.Manga, len(mangaList)),
+		byName: make(map[string][]string),
+	}
+
+	for _, m := range mangaList {
+		index.manga[m.ID] = m
+		index.add(m.Title, m.ID)
+		for _, alias := range aliases[m.ID] {
+			index.add(alias, m.ID)
+		}
+	}
+
+	return index
+}
+
+func (i *catalogIndex) add(title, mangaID string) {
+	key := NormalizeTitle(title)
+	if key == "" {
+		return
+	}
+	for _, id := range i.byName[key] {
+		if id == mangaID {
+			return
+		}
+	}
+	i.byName[key] = append(i.byName[key], mangaID)
+}
+
+// match returns the single catalog entry for a title, or the reason there is none
+func (i *catalogIndex) match(title string) (*models.Manga, string) {
+	ids := i.byName[NormalizeTitle(title)]
+
+	switch len(ids) {
+	case 0:
+		return nil, "no catalog entry matches this title"
+	case 1:
+		m := i.manga[ids[0]]
+		return &m, ""
+	default:
+		return nil, "title matches several catalog entries: " + strings.Join(ids, ", ")
+	}
+}
+
+// NormalizeTitle lowercases a title and reduces punctuation and spacing to single spaces
+func NormalizeTitle(title string) string {
+	mapped := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, title)
+
+	return strings.Join(strings.Fields(mapped), " ")
+}
@@ -0,0 +1,168 @@
+package library
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Export is a rendered library export file
+type Export struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Export renders the user's whole library in the requested format
+func (s *Service) Export(user *models.User, format string) (*Export, error) {
+	if format == "" {
+		format = models.ExportFormatJSON
+	}
+
+	entries, _, err := s.mangaService.GetUserLibrary(user.ID, models.LibraryQuery{Sort: models.LibrarySortTitle})
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []models.UserProgressWithManga{}
+	}
+
+	var buf bytes.Buffer
+	export := &Export{
+		Filename: fmt.Sprintf("mangahub-library-%s-%s", user.Username, time.Now().Format("20060102")),
+	}
+
+	switch format {
+	case models.ExportFormatMAL:
+		err = writeMAL(&buf, user.Username, entries)
+		export.Filename += ".xml"
+		export.ContentType = "application/xml; charset=utf-8"
+	case models.ExportFormatCSV:
+		err = writeCSV(&buf, entries)
+		export.Filename += ".csv"
+		export.ContentType = "text/csv; charset=utf-8"
+	case models.ExportFormatJSON:
+		err = writeJSON(&buf, entries)
+		export.Filename += ".json"
+		export.ContentType = "application/json; charset=utf-8"
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export library: %w", err)
+	}
+
+	export.Data = buf.Bytes()
+	return export, nil
+}
+
+// writeMAL writes the library as a MyAnimeList manga list export
+func writeMAL(buf *bytes.Buffer, username string, entries []models.UserProgressWithManga) error {
+	info := &malMyInfo{UserName: username, ExportType: 2, TotalManga: len(entries)}
+	list := malExport{MyInfo: info, Manga: make([]malManga, 0, len(entries))}
+
+	for _, entry := range entries {
+		switch entry.Status {
+		case models.ReadingStatusReading:
+			info.TotalReading++
+		case models.ReadingStatusCompleted:
+			info.TotalCompleted++
+		case models.ReadingStatusOnHold:
+			info.TotalOnHold++
+		case models.ReadingStatusDropped:
+			info.TotalDropped++
+		default:
+			info.TotalPlanToRead++
+		}
+
+		list.Manga = append(list.Manga, malManga{
+			// MyAnimeList IDs are not stored in the catalog
+			MangaDBID:      "0",
+			Title:          malCDATA{Value: entry.Manga.Title},
+			Volumes:        "0",
+			Chapters:       strconv.Itoa(entry.Manga.TotalChapters),
+			ReadVolumes:    "0",
+			ReadChapters:   strconv.Itoa(entry.CurrentChapter.Whole()),
+			StartDate:      malDate(entry.StartedAt),
+			FinishDate:     malDate(entry.CompletedAt),
+			Score:          strconv.Itoa(entry.GetRatingValue()),
+			Status:         malStatus(entry.Status),
+			TimesRead:      "0",
+			Tags:           malCDATA{Value: strings.Join(entry.Tags, ", ")},
+			Rereading:      "NO",
+			UpdateOnImport: "1",
+		})
+	}
+
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(buf)
+	encoder.Indent("", "\t")
+	if err := encoder.Encode(list); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+	return nil
+}
+
+// writeCSV writes the library as CSV with one row per entry
+func writeCSV(buf *bytes.Buffer, entries []models.UserProgressWithManga) error {
+	w := csv.NewWriter(buf)
+	w.Write([]string{
+		"manga_id", "title", "author", "status", "current_chapter", "total_chapters",
+		"rating", "started_at", "completed_at", "updated_at", "shelves", "tags",
+	})
+
+	for _, entry := range entries {
+		rating := ""
+		if entry.Rating != nil {
+			rating = strconv.Itoa(*entry.Rating)
+		}
+
+		w.Write([]string{
+			entry.MangaID,
+			entry.Manga.Title,
+			entry.Manga.Author,
+			string(entry.Status),
+			entry.CurrentChapter.String(),
+			strconv.Itoa(entry.Manga.TotalChapters),
+			rating,
+			csvTime(entry.StartedAt.Time, entry.StartedAt.Valid),
+			csvTime(entry.CompletedAt.Time, entry.CompletedAt.Valid),
+			csvTime(entry.UpdatedAt, true),
+			strings.Join(entry.Shelves, ";"),
+			strings.Join(entry.Tags, ";"),
+		})
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func csvTime(t time.Time, valid bool) string {
+	if !valid || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// writeJSON writes the library entries as an indented JSON document
+func writeJSON(buf *bytes.Buffer, entries []models.UserProgressWithManga) error {
+	encoder := json.NewEncoder(buf)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		ExportedAt time.Time                      `json:"exported_at"`
+		Count      int                            `json:"count"`
+		Items      []models.UserProgressWithManga `json:"items"`
+	}{
+		ExportedAt: time.Now().UTC(),
+		Count:      len(entries),
+		Items:      entries,
+	})
+}
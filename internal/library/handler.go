@@ -0,0 +1,100 @@
+package library
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// maxImportSize bounds the size of an uploaded library file (10 MB)
+const maxImportSize = 10 << 20
+
+// Handler handles library import/export HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new library import/export handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Import imports a library file uploaded as the multipart field "file" or as the raw request body
+// POST /users/library/import?format=mal&overwrite=false&dry_run=false
+func (h *Handler) Import(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	user := userInterface.(*models.User)
+
+	var query models.LibraryImportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var body io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			response.BadRequest(c, "Missing import file")
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			response.BadRequest(c, "Invalid import file")
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	result, err := h.service.Import(user.ID, query, body)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid import file") || strings.HasPrefix(err.Error(), "unsupported import format") {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalError(c, "Failed to import library")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"result": result})
+}
+
+// Export downloads the user's library as a file
+// GET /users/library/export?format=mal|csv|json
+func (h *Handler) Export(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	user := userInterface.(*models.User)
+
+	var query models.LibraryExportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid export format (use mal, csv or json)")
+		return
+	}
+
+	export, err := h.service.Export(user, query.Format)
+	if err != nil {
+		response.InternalError(c, "Failed to export library")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+	c.Data(http.StatusOK, export.ContentType, export.Data)
+}
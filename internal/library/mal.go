@@ -0,0 +1,154 @@
+package library
+
+import (
+	"bufio"
+	"compress/gzip"
+	"database/sql"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// malExport is the root of a MyAnimeList manga list export
+type malExport struct {
+	XMLName xml.Name   `xml:"myanimelist"`
+	MyInfo  *malMyInfo `xml:"myinfo,omitempty"`
+	Manga   []malManga `xml:"manga"`
+}
+
+// malMyInfo is the export header describing the list owner
+type malMyInfo struct {
+	UserName        string `xml:"user_name"`
+	ExportType      int    `xml:"user_export_type"` // 2 = manga list
+	TotalManga      int    `xml:"user_total_manga"`
+	TotalReading    int    `xml:"user_total_reading"`
+	TotalCompleted  int    `xml:"user_total_completed"`
+	TotalOnHold     int    `xml:"user_total_onhold"`
+	TotalDropped    int    `xml:"user_total_dropped"`
+	TotalPlanToRead int    `xml:"user_total_plantoread"`
+}
+
+// malManga is a single entry of a MyAnimeList manga list export.
+// Numeric fields are kept as strings because MAL leaves some of them empty.
+type malManga struct {
+	MangaDBID      string   `xml:"manga_mangadb_id"`
+	Title          malCDATA `xml:"manga_title"`
+	Volumes        string   `xml:"manga_volumes"`
+	Chapters       string   `xml:"manga_chapters"`
+	ReadVolumes    string   `xml:"my_read_volumes"`
+	ReadChapters   string   `xml:"my_read_chapters"`
+	StartDate      string   `xml:"my_start_date"`
+	FinishDate     string   `xml:"my_finish_date"`
+	Score          string   `xml:"my_score"`
+	Status         string   `xml:"my_status"`
+	Comments       malCDATA `xml:"my_comments"`
+	TimesRead      string   `xml:"my_times_read"`
+	Tags           malCDATA `xml:"my_tags"`
+	Rereading      string   `xml:"my_rereading"`
+	UpdateOnImport string   `xml:"update_on_import"`
+}
+
+// malCDATA is text that MAL wraps in a CDATA section
+type malCDATA struct {
+	Value string `xml:",cdata"`
+}
+
+// maxDecompressedImportSize bounds how large a gzip-compressed export may
+// grow when decompressed (50 MB), so a small upload cannot expand without limit
+const maxDecompressedImportSize = 50 << 20
+
+// parseMAL reads a MyAnimeList manga list export, plain or gzip-compressed
+func parseMAL(r io.Reader) ([]malManga, error) {
+	br := bufio.NewReader(r)
+
+	// MAL serves its exports as .xml.gz; accept both
+	var limited *io.LimitedReader
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("invalid import file: %w", err)
+		}
+		defer gz.Close()
+		// One byte past the cap tells an oversized export from one that fits exactly
+		limited = &io.LimitedReader{R: gz, N: maxDecompressedImportSize + 1}
+		r = limited
+	} else {
+		r = br
+	}
+
+	var export malExport
+	if err := xml.NewDecoder(r).Decode(&export); err != nil {
+		if limited != nil && limited.N == 0 {
+			return nil, fmt.Errorf("invalid import file: larger than %d MB when decompressed", maxDecompressedImportSize>>20)
+		}
+		return nil, fmt.Errorf("invalid import file: %w", err)
+	}
+
+	return export.Manga, nil
+}
+
+// readingStatusFromMAL maps a MAL list status (by name or numeric code) to a ReadingStatus
+func readingStatusFromMAL(status string) (models.ReadingStatus, bool) {
+	key := strings.ToLower(strings.NewReplacer("-", "", " ", "", "_", "").Replace(status))
+
+	switch key {
+	case "reading", "1":
+		return models.ReadingStatusReading, true
+	case "completed", "2":
+		return models.ReadingStatusCompleted, true
+	case "onhold", "3":
+		return models.ReadingStatusOnHold, true
+	case "dropped", "4":
+		return models.ReadingStatusDropped, true
+	case "plantoread", "6":
+		return models.ReadingStatusPlanToRead, true
+	}
+
+	return "", false
+}
+
+// malStatus maps a ReadingStatus to its MAL list status name
+func malStatus(status models.ReadingStatus) string {
+	switch status {
+	case models.ReadingStatusReading:
+		return "Reading"
+	case models.ReadingStatusCompleted:
+		return "Completed"
+	case models.ReadingStatusOnHold:
+		return "On-Hold"
+	case models.ReadingStatusDropped:
+		return "Dropped"
+	default:
+		return "Plan to Read"
+	}
+}
+
+// malDate formats a timestamp as a MAL list date, using MAL's zero date when unset
+func malDate(t sql.NullTime) string {
+	if !t.Valid {
+		return "0000-00-00"
+	}
+	return t.Time.Format("2006-01-02")
+}
+
+// ratingFromMAL maps a MAL score (0 = unscored, 1-10) to a rating
+func ratingFromMAL(score string) *int {
+	value := malInt(score)
+	if value < 1 || value > 10 {
+		return nil
+	}
+	return &value
+}
+
+// malInt parses a MAL numeric field, treating empty or malformed values as 0
+func malInt(value string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0
+	}
+	return n
+}
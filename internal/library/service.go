@@ -0,0 +1,151 @@
+package library
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Service imports and exports user libraries
+type Service struct {
+	mangaService *manga.Service
+}
+
+// NewService creates a new library import/export service
+func NewService(mangaService *manga.Service) *Service {
+	return &Service{mangaService: mangaService}
+}
+
+// Import reads a library file and writes its entries to the user's library.
+// Entries are written one by one; a rejected entry is reported and does not stop the import.
+func (s *Service) Import(userID string, query models.LibraryImportQuery, r io.Reader) (*models.ImportResult, error) {
+	format := query.Format
+	if format == "" {
+		format = models.ImportFormatMAL
+	}
+	if format != models.ImportFormatMAL {
+		return nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+
+	entries, err := parseMAL(r)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := s.catalogIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ImportResult{
+		Format:    format,
+		DryRun:    query.DryRun,
+		Total:     len(entries),
+		Skipped:   []models.ImportIssue{},
+		Unmatched: []models.ImportIssue{},
+		Failed:    []models.ImportIssue{},
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		issue := models.ImportIssue{Title: entry.Title.Value, SourceID: entry.MangaDBID}
+
+		m, reason := index.match(entry.Title.Value)
+		if m == nil {
+			issue.Reason = reason
+			result.Unmatched = append(result.Unmatched, issue)
+			continue
+		}
+		issue.MangaID = m.ID
+
+		status, ok := readingStatusFromMAL(entry.Status)
+		if !ok {
+			issue.Reason = fmt.Sprintf("unknown MAL status %q", entry.Status)
+			result.Failed = append(result.Failed, issue)
+			continue
+		}
+
+		if seen[m.ID] {
+			issue.Reason = "listed more than once in the import file"
+			result.Skipped = append(result.Skipped, issue)
+			continue
+		}
+		seen[m.ID] = true
+
+		chapter := importedChapter(malInt(entry.ReadChapters), status, m.TotalChapters)
+		rating := ratingFromMAL(entry.Score)
+
+		if _, err := s.mangaService.GetProgress(userID, m.ID); err == nil {
+			if !query.Overwrite {
+				issue.Reason = "already in library"
+				result.Skipped = append(result.Skipped, issue)
+				continue
+			}
+
+			if !query.DryRun {
+				req := models.ProgressUpdateRequest{Status: &status, Rating: rating}
+				// Chapter progress can only be recorded when the total is known
+				if m.TotalChapters > 0 {
+					req.CurrentChapter = &chapter
+				}
+				if err := s.mangaService.UpdateProgress(userID, m.ID, req); err != nil {
+					issue.Reason = err.Error()
+					result.Failed = append(result.Failed, issue)
+					continue
+				}
+			}
+			result.Updated++
+			continue
+		}
+
+		if !query.DryRun {
+			err := s.mangaService.AddToLibrary(userID, models.LibraryAddRequest{
+				MangaID:        m.ID,
+				Status:         status,
+				CurrentChapter: chapter,
+				Rating:         rating,
+			})
+			if err != nil {
+				issue.Reason = err.Error()
+				result.Failed = append(result.Failed, issue)
+				continue
+			}
+		}
+		result.Added++
+	}
+
+	return result, nil
+}
+
+// catalogIndex loads the whole catalog with its aliases for title matching
+func (s *Service) catalogIndex() (*catalogIndex, error) {
+	mangaList, _, err := s.mangaService.GetAll(0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.mangaService.GetAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCatalogIndex(mangaList, aliases), nil
+}
+
+// importedChapter fits an imported chapter count to the catalog's chapter total.
+// Other sites often count chapters differently, so finished series are taken
+// as read to the end and counts beyond the known total are capped.
+func importedChapter(read int, status models.ReadingStatus, totalChapters int) models.ChapterNumber {
+	if read < 0 {
+		read = 0
+	}
+	if totalChapters <= 0 {
+		return models.ChapterNumber(read)
+	}
+	if status == models.ReadingStatusCompleted || read > totalChapters {
+		return models.ChapterNumber(totalChapters)
+	}
+	return models.ChapterNumber(read)
+}
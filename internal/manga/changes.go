@@ -0,0 +1,27 @@
+package manga
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Change feed tokens are the position of a change in the manga_changes log.
+// Clients treat them as opaque and only hand them back as "since".
+
+func changeToken(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+// parseChangeToken returns the position after which to read; an empty token
+// starts from the beginning of the feed
+func parseChangeToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	seq, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, fmt.Errorf("invalid change token")
+	}
+	return seq, nil
+}
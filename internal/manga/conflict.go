@@ -0,0 +1,45 @@
+package manga
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// ErrCodeVersionConflict is reported when a progress update was based on an out-of-date version
+const ErrCodeVersionConflict = "VERSION_CONFLICT"
+
+// ErrProgressConflict is returned when a progress write finds the stored version
+// has moved on. A *ConflictError matches it with errors.Is.
+var ErrProgressConflict = errors.New("progress version conflict")
+
+// maxProgressWriteAttempts bounds how often an update is re-applied when another
+// write lands between reading and writing the entry
+const maxProgressWriteAttempts = 3
+
+// ConflictError rejects a progress update made against an out-of-date version.
+// Current is the stored progress the client should reconcile with.
+type ConflictError struct {
+	Requested int
+	Current   *models.UserProgress
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("progress has changed: update was based on version %d, current version is %d", e.Requested, e.Current.Version)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrProgressConflict
+}
+
+// keepHighestChapter merges a stale update into the current progress so that
+// the further-read chapter wins. An update that would move the entry backwards
+// keeps the current chapter and status; its rating still applies.
+func keepHighestChapter(req models.ProgressUpdateRequest, current *models.UserProgress) models.ProgressUpdateRequest {
+	if req.CurrentChapter != nil && *req.CurrentChapter < current.CurrentChapter {
+		req.CurrentChapter = nil
+		req.Status = nil
+	}
+	return req
+}
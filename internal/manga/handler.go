@@ -1,6 +1,8 @@
 package manga
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -71,8 +73,30 @@ func (h *Handler) GetAll(c *gin.Context) {
 	response.Paginated(c, mangaList, total, limit, offset)
 }
 
+// GetChanges reads the catalog change feed
+// GET /manga/changes?since=<token>&limit=100
+func (h *Handler) GetChanges(c *gin.Context) {
+	var query models.MangaChangesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	page, err := h.service.GetChanges(query)
+	if err != nil {
+		if err.Error() == "invalid change token" {
+			response.BadRequest(c, "Invalid change token")
+			return
+		}
+		response.InternalError(c, "Failed to get manga changes")
+		return
+	}
+
+	response.Success(c, http.StatusOK, page)
+}
+
 // GetLibrary retrieves user's manga library
-// GET /users/library
+// GET /users/library?status=reading&sort=title&order=asc&limit=20&offset=0
 func (h *Handler) GetLibrary(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userInterface, exists := c.Get("user")
@@ -83,13 +107,29 @@ func (h *Handler) GetLibrary(c *gin.Context) {
 
 	user := userInterface.(*models.User)
 
-	library, err := h.service.GetUserLibrary(user.ID)
+	var filter models.LibraryQuery
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	library, total, err := h.service.GetUserLibrary(user.ID, filter)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid ") {
+			response.BadRequest(c, err.Error())
+			return
+		}
 		response.InternalError(c, "Failed to get library")
 		return
 	}
 
+	if filter.Limit > 0 {
+		response.Paginated(c, library, total, filter.Limit, filter.Offset)
+		return
+	}
+
 	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": library}, &response.Meta{
+		Total: total,
 		Count: len(library),
 	})
 }
@@ -128,6 +168,10 @@ func (h *Handler) AddToLibrary(c *gin.Context) {
 			return
 		}
 
+		if respondTransitionError(c, err) {
+			return
+		}
+
 		response.InternalError(c, "Failed to add manga to library")
 		return
 	}
@@ -137,6 +181,9 @@ func (h *Handler) AddToLibrary(c *gin.Context) {
 
 // UpdateProgress updates user's reading progress
 // PUT /users/progress/:manga_id
+//
+// An If-Match header (or "version" in the body) makes the update conditional on
+// the entry's current version; a stale update gets 409 with the current progress.
 func (h *Handler) UpdateProgress(c *gin.Context) {
 	// Get user ID from context
 	userInterface, exists := c.Get("user")
@@ -154,6 +201,15 @@ func (h *Handler) UpdateProgress(c *gin.Context) {
 		return
 	}
 
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" && ifMatch != "*" {
+		version, err := parseProgressETag(ifMatch)
+		if err != nil {
+			response.BadRequest(c, "Invalid If-Match header")
+			return
+		}
+		req.Version = &version
+	}
+
 	if err := h.service.UpdateProgress(user.ID, mangaID, req); err != nil {
 		if err.Error() == "manga not found" {
 			response.NotFound(c, "Manga not found")
@@ -170,11 +226,26 @@ func (h *Handler) UpdateProgress(c *gin.Context) {
 			return
 		}
 
+		if respondConflictError(c, err) {
+			return
+		}
+
+		if respondTransitionError(c, err) {
+			return
+		}
+
 		response.InternalError(c, "Failed to update progress")
 		return
 	}
 
-	response.Success(c, http.StatusOK, gin.H{"message": "Progress updated"})
+	progress, err := h.service.GetProgress(user.ID, mangaID)
+	if err != nil {
+		response.Success(c, http.StatusOK, gin.H{"message": "Progress updated"})
+		return
+	}
+
+	c.Header("ETag", progressETag(progress.Version))
+	response.Success(c, http.StatusOK, gin.H{"message": "Progress updated", "progress": progress})
 }
 
 // GetProgress retrieves user's progress for a specific manga
@@ -196,5 +267,175 @@ func (h *Handler) GetProgress(c *gin.Context) {
 		return
 	}
 
+	// Progress percent stays null while the manga's chapter count is unknown
+	var progressPercent *float64
+	if manga, err := h.service.GetByID(mangaID); err == nil {
+		progressPercent = progress.CurrentChapter.PercentOf(manga.TotalChapters)
+	}
+
+	c.Header("ETag", progressETag(progress.Version))
+	response.Success(c, http.StatusOK, gin.H{"progress": progress, "progress_percent": progressPercent})
+}
+
+// StartReread begins a new read-through of a completed series
+// POST /users/library/:manga_id/reread
+func (h *Handler) StartReread(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	user := userInterface.(*models.User)
+	mangaID := c.Param("manga_id")
+
+	if err := h.service.StartReread(user.ID, mangaID); err != nil {
+		if err.Error() == "manga not found" || err.Error() == "manga not in user's library" {
+			response.NotFound(c, "Manga not in library")
+			return
+		}
+
+		if respondConflictError(c, err) {
+			return
+		}
+
+		if respondTransitionError(c, err) {
+			return
+		}
+
+		response.InternalError(c, "Failed to start reread")
+		return
+	}
+
+	progress, err := h.service.GetProgress(user.ID, mangaID)
+	if err != nil {
+		response.InternalError(c, "Failed to get progress")
+		return
+	}
+
+	c.Header("ETag", progressETag(progress.Version))
+	response.Success(c, http.StatusOK, gin.H{"progress": progress})
+}
+
+// UpdateEntry sets the favorite flag, priority and hidden flag of a library entry
+// PATCH /users/library/:manga_id
+func (h *Handler) UpdateEntry(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	user := userInterface.(*models.User)
+	mangaID := c.Param("manga_id")
+
+	var req models.LibraryEntryUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	progress, err := h.service.UpdateEntry(user.ID, mangaID, req)
+	if err != nil {
+		switch {
+		case err.Error() == "manga not in user's library":
+			response.NotFound(c, "Manga not in library")
+		case strings.HasPrefix(err.Error(), "invalid "):
+			response.BadRequest(c, err.Error())
+		default:
+			response.InternalError(c, "Failed to update library entry")
+		}
+		return
+	}
+
 	response.Success(c, http.StatusOK, gin.H{"progress": progress})
 }
+
+// GetReadThroughs lists the passes through a series in the user's library
+// GET /users/library/:manga_id/reads
+func (h *Handler) GetReadThroughs(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	user := userInterface.(*models.User)
+
+	readThroughs, err := h.service.GetReadThroughs(user.ID, c.Param("manga_id"))
+	if err != nil {
+		if err.Error() == "manga not in user's library" {
+			response.NotFound(c, "Manga not in library")
+			return
+		}
+
+		response.InternalError(c, "Failed to get read-throughs")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": readThroughs}, &response.Meta{
+		Total: len(readThroughs),
+		Count: len(readThroughs),
+	})
+}
+
+// GetChapterSuggestions lists suggested total chapter counts for manga whose count is unknown
+// GET /admin/manga/chapter-suggestions
+func (h *Handler) GetChapterSuggestions(c *gin.Context) {
+	suggestions, err := h.service.GetChapterSuggestions()
+	if err != nil {
+		response.InternalError(c, "Failed to get chapter suggestions")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": suggestions}, &response.Meta{
+		Total: len(suggestions),
+		Count: len(suggestions),
+	})
+}
+
+// respondTransitionError reports a rejected reading status change with its error code.
+// It returns false when err is not a status transition error.
+func respondTransitionError(c *gin.Context, err error) bool {
+	var transitionErr *TransitionError
+	if !errors.As(err, &transitionErr) {
+		return false
+	}
+
+	response.Error(c, http.StatusUnprocessableEntity, transitionErr.Code, transitionErr.Message)
+	return true
+}
+
+// respondConflictError reports a progress version conflict, with the current
+// progress and its ETag when known. It returns false when err is not a conflict.
+func respondConflictError(c *gin.Context, err error) bool {
+	if !errors.Is(err, ErrProgressConflict) {
+		return false
+	}
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		response.Error(c, http.StatusConflict, ErrCodeVersionConflict, "progress has changed")
+		return true
+	}
+
+	c.Header("ETag", progressETag(conflictErr.Current.Version))
+	response.ErrorWithData(c, http.StatusConflict, ErrCodeVersionConflict, conflictErr.Error(),
+		gin.H{"progress": conflictErr.Current})
+	return true
+}
+
+// progressETag formats a progress version as an entity tag
+func progressETag(version int) string {
+	return fmt.Sprintf("%q", strconv.Itoa(version))
+}
+
+// parseProgressETag reads the version out of an If-Match value such as "3", W/"3" or 3
+func parseProgressETag(value string) (int, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	version, err := strconv.Atoi(strings.Trim(value, `"`))
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("invalid version: %s", value)
+	}
+	return version, nil
+}
@@ -19,13 +19,43 @@ func NewRepository(db *sql.DB) *Repository {
 
 const mangaSelectFields = `id, title, author, genres, status, total_chapters, description, cover_image_url, created_at, updated_at`
 
+// mangaRatingFields are the rating histogram from the manga_ratings row joined as
+// mr and the catalog-wide mean rating joined as g (see mangaRatingJoins)
+const mangaRatingFields = `COALESCE(mr.r1, 0), COALESCE(mr.r2, 0), COALESCE(mr.r3, 0), COALESCE(mr.r4, 0), COALESCE(mr.r5, 0),
+	COALESCE(mr.r6, 0), COALESCE(mr.r7, 0), COALESCE(mr.r8, 0), COALESCE(mr.r9, 0), COALESCE(mr.r10, 0), g.mean`
+
+// mangaScoreOrder ranks manga by weighted score, unrated manga last.
+// It must agree with models.NewCommunityRating.
+var mangaScoreOrder = fmt.Sprintf(
+	"COALESCE(mr.rating_count, 0) = 0, (%[1]d * g.mean + mr.rating_sum) / (%[1]d + mr.rating_count) DESC",
+	models.RatingPriorWeight,
+)
+
+// mangaRatingJoins joins the rating aggregate of the manga table aliased as table
+func mangaRatingJoins(table string) string {
+	return fmt.Sprintf(`
+		LEFT JOIN manga_ratings mr ON mr.manga_id = %s.id
+		CROSS JOIN (SELECT CAST(SUM(rating_sum) AS REAL) / SUM(rating_count) AS mean FROM manga_ratings) g`, table)
+}
+
+// ratingDest returns the scan destinations for mangaRatingFields
+func ratingDest(histogram *[10]int, catalogMean *sql.NullFloat64) []interface{} {
+	dest := make([]interface{}, 0, len(histogram)+1)
+	for i := range histogram {
+		dest = append(dest, &histogram[i])
+	}
+	return append(dest, catalogMean)
+}
+
 func scanManga(scanner interface {
 	Scan(dest ...interface{}) error
 }) (*models.Manga, error) {
 	var manga models.Manga
 	var genresJSON string
+	var histogram [10]int
+	var catalogMean sql.NullFloat64
 
-	err := scanner.Scan(
+	dest := []interface{}{
 		&manga.ID,
 		&manga.Title,
 		&manga.Author,
@@ -36,8 +66,8 @@ func scanManga(scanner interface {
 		&manga.CoverImageURL,
 		&manga.CreatedAt,
 		&manga.UpdatedAt,
-	)
-	if err != nil {
+	}
+	if err := scanner.Scan(append(dest, ratingDest(&histogram, &catalogMean)...)...); err != nil {
 		return nil, err
 	}
 
@@ -45,16 +75,17 @@ func scanManga(scanner interface {
 	if err := manga.UnmarshalGenres(genresJSON); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
 	}
+	manga.CommunityRating = models.NewCommunityRating(histogram, catalogMean.Float64)
 
 	return &manga, nil
 }
 
 func (r *Repository) FindByID(id string) (*models.Manga, error) {
 	query := fmt.Sprintf(`
-		SELECT %s
-		FROM manga
+		SELECT %s, %s
+		FROM manga %s
 		WHERE id = ?
-	`, mangaSelectFields)
+	`, mangaSelectFields, mangaRatingFields, mangaRatingJoins("manga"))
 
 	manga, err := scanManga(r.db.QueryRow(query, id))
 	if err == sql.ErrNoRows {
@@ -95,6 +126,12 @@ func (r *Repository) Search(query models.MangaSearchQuery) ([]models.Manga, int,
 		args = append(args, query.Status)
 	}
 
+	// Only manga changed since the given time (inclusive, so equal timestamps are not missed)
+	if !query.UpdatedSince.IsZero() {
+		whereClauses = append(whereClauses, "datetime(updated_at) >= datetime(?)")
+		args = append(args, query.UpdatedSince.UTC().Format("2006-01-02 15:04:05"))
+	}
+
 	whereSQL := strings.Join(whereClauses, " AND ")
 
 	// 1. Get total count
@@ -105,13 +142,21 @@ func (r *Repository) Search(query models.MangaSearchQuery) ([]models.Manga, int,
 		return nil, 0, fmt.Errorf("failed to get count: %w", err)
 	}
 
+	orderBy := "title ASC"
+	switch query.Sort {
+	case models.MangaSortScore:
+		orderBy = mangaScoreOrder + ", title ASC"
+	case models.MangaSortUpdated:
+		orderBy = "datetime(manga.updated_at) ASC, manga.id ASC"
+	}
+
 	// 2. Get data
 	sqlQuery := fmt.Sprintf(`
-		SELECT %s
-		FROM manga
+		SELECT %s, %s
+		FROM manga %s
 		WHERE %s
-		ORDER BY title ASC
-	`, mangaSelectFields, whereSQL)
+		ORDER BY %s
+	`, mangaSelectFields, mangaRatingFields, mangaRatingJoins("manga"), whereSQL, orderBy)
 
 	// Add pagination only if limit > 0
 	if query.Limit > 0 {
@@ -167,10 +212,10 @@ func (r *Repository) FindAll(limit, offset int) ([]models.Manga, int, error) {
 
 	// 2. Get data
 	query := fmt.Sprintf(`
-		SELECT %s
-		FROM manga
+		SELECT %s, %s
+		FROM manga %s
 		ORDER BY title ASC
-	`, mangaSelectFields)
+	`, mangaSelectFields, mangaRatingFields, mangaRatingJoins("manga"))
 
 	args := []interface{}{}
 	if limit > 0 {
@@ -191,23 +236,239 @@ func (r *Repository) FindAll(limit, offset int) ([]models.Manga, int, error) {
 	return mangaList, total, nil
 }
 
+// FindChangesSince reads up to limit catalog changes logged after position seq,
+// oldest first, with the current body of each manga that still exists
+func (r *Repository) FindChangesSince(seq int64, limit int) ([]models.MangaChange, error) {
+	rows, err := r.db.Query(`
+		SELECT seq, manga_id, change_type, changed_at
+		FROM manga_changes
+		WHERE seq > ?
+		ORDER BY seq
+		LIMIT ?
+	`, seq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query manga changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []models.MangaChange
+	var ids []interface{}
+	for rows.Next() {
+		var change models.MangaChange
+		var changeSeq int64
+		if err := rows.Scan(&changeSeq, &change.MangaID, &change.Type, &change.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan manga change: %w", err)
+		}
+		change.Token = changeToken(changeSeq)
+		if change.Type != models.MangaChangeDeleted {
+			ids = append(ids, change.MangaID)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating manga changes: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return changes, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, %s
+		FROM manga %s
+		WHERE id IN (?%s)
+	`, mangaSelectFields, mangaRatingFields, mangaRatingJoins("manga"), strings.Repeat(", ?", len(ids)-1))
+
+	mangaList, err := r.queryMangaList(query, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	bodies := make(map[string]*models.Manga, len(mangaList))
+	for i := range mangaList {
+		bodies[mangaList[i].ID] = &mangaList[i]
+	}
+	for i := range changes {
+		if changes[i].Type != models.MangaChangeDeleted {
+			changes[i].Manga = bodies[changes[i].MangaID]
+		}
+	}
+
+	return changes, nil
+}
+
+// FindAliases retrieves the alternative titles of every manga, keyed by manga ID
+func (r *Repository) FindAliases() (map[string][]string, error) {
+	rows, err := r.db.Query(`SELECT manga_id, alias FROM manga_aliases ORDER BY manga_id, alias`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query manga aliases: %w", err)
+	}
+	defer rows.Close()
+
+	aliases := make(map[string][]string)
+	for rows.Next() {
+		var mangaID, alias string
+		if err := rows.Scan(&mangaID, &alias); err != nil {
+			return nil, fmt.Errorf("failed to scan manga alias: %w", err)
+		}
+		aliases[mangaID] = append(aliases[mangaID], alias)
+	}
+
+	return aliases, rows.Err()
+}
+
+// FindChapterSuggestions reports the high-water mark of reader progress for each
+// manga without a known chapter count, most-read first. The mark covers every
+// chapter a reader ever reached, so rereads and going back do not lower it.
+func (r *Repository) FindChapterSuggestions() ([]models.ChapterSuggestion, error) {
+	rows, err := r.db.Query(`
+		WITH reached AS (
+			SELECT user_id, manga_id, current_chapter AS chapter FROM user_progress
+			UNION ALL
+			SELECT user_id, manga_id, to_chapter FROM progress_history
+			UNION ALL
+			SELECT user_id, manga_id, chapter FROM read_throughs
+		), readers AS (
+			SELECT user_id, manga_id, MAX(chapter) AS chapter
+			FROM reached
+			GROUP BY user_id, manga_id
+		)
+		SELECT m.id, m.title, MAX(rd.chapter), COUNT(*)
+		FROM manga m
+		JOIN readers rd ON rd.manga_id = m.id
+		WHERE m.total_chapters <= 0 AND rd.chapter > 0
+		GROUP BY m.id, m.title
+		ORDER BY COUNT(*) DESC, m.title ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chapter suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	suggestions := []models.ChapterSuggestion{}
+	for rows.Next() {
+		var s models.ChapterSuggestion
+		if err := rows.Scan(&s.MangaID, &s.Title, &s.HighestChapter, &s.Readers); err != nil {
+			return nil, fmt.Errorf("failed to scan chapter suggestion: %w", err)
+		}
+		s.SuggestedTotal = s.HighestChapter.Whole()
+		suggestions = append(suggestions, s)
+	}
+
+	return suggestions, rows.Err()
+}
+
 // GetUserLibrary retrieves a user's manga library with progress
-func (r *Repository) GetUserLibrary(userID string) ([]models.UserProgressWithManga, error) {
-	query := `
+func (r *Repository) GetUserLibrary(userID string, filter models.LibraryQuery) ([]models.UserProgressWithManga, int, error) {
+	whereClauses := []string{"up.user_id = ?"}
+	args := []interface{}{userID}
+
+	// Filter by shelf (matched by ID or name)
+	if filter.Shelf != "" {
+		whereClauses = append(whereClauses, `up.manga_id IN (
+			SELECT se.manga_id FROM shelf_entries se
+			JOIN shelves s ON s.id = se.shelf_id
+			WHERE s.user_id = up.user_id AND (s.id = ? OR LOWER(s.name) = LOWER(?))
+				AND (? OR s.visibility = 'public')
+		)`)
+		args = append(args, filter.Shelf, filter.Shelf, !filter.PublicShelvesOnly)
+	}
+
+	// Filter by tag (tags are stored lowercase)
+	if filter.Tag != "" {
+		whereClauses = append(whereClauses, `EXISTS (
+			SELECT 1 FROM library_tags lt
+			WHERE lt.user_id = up.user_id AND lt.manga_id = up.manga_id AND lt.tag = LOWER(?)
+		)`)
+		args = append(args, strings.TrimSpace(filter.Tag))
+	}
+
+	if filter.Status != "" {
+		whereClauses = append(whereClauses, "up.status = ?")
+		args = append(args, filter.Status)
+	}
+
+	// Genre filter (searches within JSON array)
+	if filter.Genre != "" {
+		whereClauses = append(whereClauses, "LOWER(m.genres) LIKE LOWER(?)")
+		args = append(args, "%"+filter.Genre+"%")
+	}
+
+	if filter.Q != "" {
+		whereClauses = append(whereClauses, "LOWER(m.title) LIKE LOWER(?)")
+		args = append(args, "%"+filter.Q+"%")
+	}
+
+	if filter.MinRating > 0 {
+		whereClauses = append(whereClauses, "up.rating >= ?")
+		args = append(args, filter.MinRating)
+	}
+	if filter.MaxRating > 0 {
+		whereClauses = append(whereClauses, "up.rating <= ?")
+		args = append(args, filter.MaxRating)
+	}
+
+	// Unread chapters can only be known when the manga's chapter count is known
+	if filter.HasUnread != nil {
+		if *filter.HasUnread {
+			whereClauses = append(whereClauses, "m.total_chapters > up.current_chapter")
+		} else {
+			whereClauses = append(whereClauses, "m.total_chapters <= up.current_chapter")
+		}
+	}
+
+	if filter.ExcludeHidden {
+		whereClauses = append(whereClauses, "up.hidden = 0")
+	}
+
+	if filter.Favorite != nil {
+		whereClauses = append(whereClauses, "up.favorite = ?")
+		args = append(args, *filter.Favorite)
+	}
+
+	whereSQL := strings.Join(whereClauses, " AND ")
+
+	// 1. Get total count
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM user_progress up
+		JOIN manga m ON up.manga_id = m.id
+		WHERE %s
+	`, whereSQL)
+	var total int
+	if err := r.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count user library: %w", err)
+	}
+
+	// 2. Get data
+	query := fmt.Sprintf(`
 		SELECT
 			up.user_id, up.manga_id, up.current_chapter, up.status, up.rating,
-			up.started_at, up.completed_at, up.updated_at,
+			up.started_at, up.completed_at, up.updated_at, up.reread_count, up.version,
+			up.favorite, up.priority, up.hidden,
 			m.id, m.title, m.author, m.genres, m.status, m.total_chapters,
-			m.description, m.cover_image_url, m.created_at, m.updated_at
+			m.description, m.cover_image_url, m.created_at, m.updated_at,
+			%s
 		FROM user_progress up
-		JOIN manga m ON up.manga_id = m.id
-		WHERE up.user_id = ?
-		ORDER BY up.updated_at DESC
-	`
+		JOIN manga m ON up.manga_id = m.id %s
+		WHERE %s
+		ORDER BY %s
+	`, mangaRatingFields, mangaRatingJoins("m"), whereSQL, libraryOrderBy(filter.Sort, filter.Order))
+
+	// Add pagination only if limit > 0
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
 
-	rows, err := r.db.Query(query, userID)
+		if filter.Offset > 0 {
+			query += " OFFSET ?"
+			args = append(args, filter.Offset)
+		}
+	}
+
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user library: %w", err)
+		return nil, 0, fmt.Errorf("failed to get user library: %w", err)
 	}
 	defer rows.Close()
 
@@ -215,8 +476,10 @@ func (r *Repository) GetUserLibrary(userID string) ([]models.UserProgressWithMan
 	for rows.Next() {
 		var item models.UserProgressWithManga
 		var genresJSON string
+		var histogram [10]int
+		var catalogMean sql.NullFloat64
 
-		err := rows.Scan(
+		dest := []interface{}{
 			&item.UserID,
 			&item.MangaID,
 			&item.CurrentChapter,
@@ -225,6 +488,11 @@ func (r *Repository) GetUserLibrary(userID string) ([]models.UserProgressWithMan
 			&item.StartedAt,
 			&item.CompletedAt,
 			&item.UpdatedAt,
+			&item.RereadCount,
+			&item.Version,
+			&item.Favorite,
+			&item.Priority,
+			&item.Hidden,
 			&item.Manga.ID,
 			&item.Manga.Title,
 			&item.Manga.Author,
@@ -235,33 +503,137 @@ func (r *Repository) GetUserLibrary(userID string) ([]models.UserProgressWithMan
 			&item.Manga.CoverImageURL,
 			&item.Manga.CreatedAt,
 			&item.Manga.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan library item: %w", err)
+		}
+		if err := rows.Scan(append(dest, ratingDest(&histogram, &catalogMean)...)...); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan library item: %w", err)
 		}
 
 		if err := item.Manga.UnmarshalGenres(genresJSON); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
+			return nil, 0, fmt.Errorf("failed to unmarshal genres: %w", err)
 		}
+		item.Manga.CommunityRating = models.NewCommunityRating(histogram, catalogMean.Float64)
+		item.ProgressPercent = item.CurrentChapter.PercentOf(item.Manga.TotalChapters)
 
 		library = append(library, item)
 	}
 
-	return library, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.attachLabels(userID, library, filter.PublicShelvesOnly); err != nil {
+		return nil, 0, err
+	}
+
+	return library, total, nil
+}
+
+// libraryOrderBy builds the ORDER BY clause for a library sort key.
+// Entries without a rating or a known chapter count always sort last.
+func libraryOrderBy(sort, order string) string {
+	var clause string
+	switch sort {
+	case models.LibrarySortTitle:
+		if order == "" {
+			order = "asc"
+		}
+		clause = "m.title %s"
+	case models.LibrarySortRating:
+		clause = "up.rating IS NULL, up.rating %s"
+	case models.LibrarySortProgress:
+		clause = "m.total_chapters <= 0, CAST(up.current_chapter AS REAL) / NULLIF(m.total_chapters, 0) %s"
+	case models.LibrarySortPriority:
+		clause = "up.priority %[1]s, up.favorite %[1]s, up.updated_at DESC"
+	default:
+		clause = "up.updated_at %s"
+	}
+
+	if order == "" {
+		order = "desc"
+	}
+
+	return fmt.Sprintf(clause, strings.ToUpper(order)) + ", m.title ASC, up.manga_id ASC"
+}
+
+// attachLabels fills in the shelf names and tags of each library item,
+// leaving out private shelves when publicShelvesOnly is set
+func (r *Repository) attachLabels(userID string, library []models.UserProgressWithManga, publicShelvesOnly bool) error {
+	if len(library) == 0 {
+		return nil
+	}
+
+	shelves, err := r.queryLabels(`
+		SELECT se.manga_id, s.name
+		FROM shelf_entries se
+		JOIN shelves s ON s.id = se.shelf_id
+		WHERE se.user_id = ? AND (? OR s.visibility = 'public')
+		ORDER BY s.position ASC, s.name ASC
+	`, userID, !publicShelvesOnly)
+	if err != nil {
+		return fmt.Errorf("failed to load shelves: %w", err)
+	}
+
+	tags, err := r.queryLabels(`
+		SELECT manga_id, tag
+		FROM library_tags
+		WHERE user_id = ?
+		ORDER BY tag ASC
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load tags: %w", err)
+	}
+
+	for i := range library {
+		library[i].Shelves = shelves[library[i].MangaID]
+		library[i].Tags = tags[library[i].MangaID]
+		if library[i].Shelves == nil {
+			library[i].Shelves = []string{}
+		}
+		if library[i].Tags == nil {
+			library[i].Tags = []string{}
+		}
+	}
+
+	return nil
+}
+
+// queryLabels runs a (manga_id, label) query and groups the labels by manga ID
+func (r *Repository) queryLabels(query string, args ...interface{}) (map[string][]string, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := make(map[string][]string)
+	for rows.Next() {
+		var mangaID, label string
+		if err := rows.Scan(&mangaID, &label); err != nil {
+			return nil, err
+		}
+		labels[mangaID] = append(labels[mangaID], label)
+	}
+
+	return labels, rows.Err()
 }
 
 // AddToLibrary adds a manga to user's library
-func (r *Repository) AddToLibrary(userID, mangaID string, status models.ReadingStatus, currentChapter int) error {
+func (r *Repository) AddToLibrary(progress *models.UserProgress) error {
 	query := `
-		INSERT INTO user_progress (user_id, manga_id, status, current_chapter, updated_at)
-		VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP)
+		INSERT INTO user_progress (user_id, manga_id, status, current_chapter, rating, started_at, completed_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
 		ON CONFLICT(user_id, manga_id) DO UPDATE SET
 			status = excluded.status,
 			current_chapter = excluded.current_chapter,
-			updated_at = CURRENT_TIMESTAMP
+			rating = excluded.rating,
+			started_at = excluded.started_at,
+			completed_at = excluded.completed_at,
+			updated_at = CURRENT_TIMESTAMP,
+			version = user_progress.version + 1
 	`
 
-	_, err := r.db.Exec(query, userID, mangaID, status, currentChapter)
+	_, err := r.db.Exec(query, progress.UserID, progress.MangaID, progress.Status, progress.CurrentChapter,
+		progress.Rating, progress.StartedAt, progress.CompletedAt)
 	if err != nil {
 		return fmt.Errorf("failed to add manga to library: %w", err)
 	}
@@ -269,32 +641,57 @@ func (r *Repository) AddToLibrary(userID, mangaID string, status models.ReadingS
 	return nil
 }
 
-// UpdateProgress updates user's reading progress
-func (r *Repository) UpdateProgress(userID, mangaID string, currentChapter int, status *models.ReadingStatus, rating *int) error {
-	// Build dynamic update query
-	updates := []string{"current_chapter = ?", "updated_at = CURRENT_TIMESTAMP"}
-	args := []interface{}{currentChapter}
-
-	if status != nil {
-		updates = append(updates, "status = ?")
-		args = append(args, *status)
+// UpdateEntryFlags writes the favorite flag, priority and hidden flag of a library entry.
+// They are not progress, so neither the version nor updated_at changes.
+func (r *Repository) UpdateEntryFlags(progress *models.UserProgress) error {
+	result, err := r.db.Exec(`
+		UPDATE user_progress SET favorite = ?, priority = ?, hidden = ?
+		WHERE user_id = ? AND manga_id = ?
+	`, progress.Favorite, progress.Priority, progress.Hidden, progress.UserID, progress.MangaID)
+	if err != nil {
+		return fmt.Errorf("failed to update library entry: %w", err)
 	}
 
-	if rating != nil {
-		updates = append(updates, "rating = ?")
-		args = append(args, *rating)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("manga not in user's library")
 	}
 
-	// Add WHERE clause parameters
-	args = append(args, userID, mangaID)
+	return nil
+}
+
+// UpdateProgress writes the user's reading progress, including the status timestamps.
+// The write only applies while the stored version still matches previous.Version,
+// otherwise ErrProgressConflict is returned; on success progress.Version is set to the new version.
+// Chapter and status changes from previous are logged to progress_history, and a
+// completed pass is archived to read_throughs when the entry moves back to "reading".
+func (r *Repository) UpdateProgress(progress *models.UserProgress, previous *models.UserProgress) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-	query := fmt.Sprintf(`
+	query := `
 		UPDATE user_progress
-		SET %s
-		WHERE user_id = ? AND manga_id = ?
-	`, strings.Join(updates, ", "))
+		SET current_chapter = ?, status = ?, rating = ?, started_at = ?, completed_at = ?,
+			updated_at = CURRENT_TIMESTAMP, version = version + 1
+		WHERE user_id = ? AND manga_id = ? AND version = ?
+	`
 
-	result, err := r.db.Exec(query, args...)
+	result, err := tx.Exec(query,
+		progress.CurrentChapter,
+		progress.Status,
+		progress.Rating,
+		progress.StartedAt,
+		progress.CompletedAt,
+		progress.UserID,
+		progress.MangaID,
+		previous.Version,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to update progress: %w", err)
 	}
@@ -304,17 +701,85 @@ func (r *Repository) UpdateProgress(userID, mangaID string, currentChapter int,
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
+	// Either the entry was removed or another write bumped its version
 	if rowsAffected == 0 {
-		return fmt.Errorf("manga not in user's library")
+		return ErrProgressConflict
+	}
+
+	if progress.CurrentChapter != previous.CurrentChapter || progress.Status != previous.Status {
+		_, err = tx.Exec(`
+			INSERT INTO progress_history (user_id, manga_id, from_chapter, to_chapter, status, created_at)
+			VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+		`, progress.UserID, progress.MangaID, previous.CurrentChapter, progress.CurrentChapter, progress.Status)
+		if err != nil {
+			return fmt.Errorf("failed to record progress history: %w", err)
+		}
+	}
+
+	if startsReread(previous.Status, progress.Status) {
+		_, err = tx.Exec(`
+			INSERT INTO read_throughs (user_id, manga_id, chapter, rating, started_at, completed_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, previous.UserID, previous.MangaID, previous.CurrentChapter, previous.Rating, previous.StartedAt, previous.CompletedAt)
+		if err != nil {
+			return fmt.Errorf("failed to archive read-through: %w", err)
+		}
+
+		_, err = tx.Exec(`
+			UPDATE user_progress SET reread_count = reread_count + 1
+			WHERE user_id = ? AND manga_id = ?
+		`, progress.UserID, progress.MangaID)
+		if err != nil {
+			return fmt.Errorf("failed to count reread: %w", err)
+		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit progress: %w", err)
+	}
+
+	progress.Version = previous.Version + 1
 	return nil
 }
 
+// GetReadThroughs retrieves the archived passes of a library entry, oldest first
+func (r *Repository) GetReadThroughs(userID, mangaID string) ([]models.ReadThrough, error) {
+	rows, err := r.db.Query(`
+		SELECT chapter, rating, started_at, completed_at
+		FROM read_throughs
+		WHERE user_id = ? AND manga_id = ?
+		ORDER BY id ASC
+	`, userID, mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query read-throughs: %w", err)
+	}
+	defer rows.Close()
+
+	readThroughs := []models.ReadThrough{}
+	for rows.Next() {
+		var rt models.ReadThrough
+		var startedAt, completedAt sql.NullTime
+		if err := rows.Scan(&rt.Chapter, &rt.Rating, &startedAt, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan read-through: %w", err)
+		}
+		rt.Number = len(readThroughs) + 1
+		if startedAt.Valid {
+			rt.StartedAt = &startedAt.Time
+		}
+		if completedAt.Valid {
+			rt.CompletedAt = &completedAt.Time
+		}
+		readThroughs = append(readThroughs, rt)
+	}
+
+	return readThroughs, rows.Err()
+}
+
 // GetProgress retrieves user's progress for a specific manga
 func (r *Repository) GetProgress(userID, mangaID string) (*models.UserProgress, error) {
 	query := `
-		SELECT user_id, manga_id, current_chapter, status, rating, started_at, completed_at, updated_at
+		SELECT user_id, manga_id, current_chapter, status, rating, started_at, completed_at, updated_at, reread_count, version,
+			favorite, priority, hidden
 		FROM user_progress
 		WHERE user_id = ? AND manga_id = ?
 	`
@@ -329,6 +794,11 @@ func (r *Repository) GetProgress(userID, mangaID string) (*models.UserProgress,
 		&progress.StartedAt,
 		&progress.CompletedAt,
 		&progress.UpdatedAt,
+		&progress.RereadCount,
+		&progress.Version,
+		&progress.Favorite,
+		&progress.Priority,
+		&progress.Hidden,
 	)
 
 	if err == sql.ErrNoRows {
@@ -1,7 +1,9 @@
 package manga
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/tnphucccc/mangahub/internal/user"
@@ -14,6 +16,8 @@ type Service struct {
 	userRepo            *user.Repository
 	TCPBroadcastChan    chan models.TCPProgressBroadcast
 	UDPNotificationChan chan models.UDPNotification
+	progressListeners   []func(userID string) error
+	changeListeners     []func(before, after models.UserProgress) error
 }
 
 // NewService creates a new manga service
@@ -26,6 +30,17 @@ func NewService(repo *Repository, userRepo *user.Repository) *Service {
 	}
 }
 
+// OnProgressUpdate registers fn to run after a user's reading progress changes
+func (s *Service) OnProgressUpdate(fn func(userID string) error) {
+	s.progressListeners = append(s.progressListeners, fn)
+}
+
+// OnProgressChange registers fn to run after a progress update with the entry as
+// it was before and after the update
+func (s *Service) OnProgressChange(fn func(before, after models.UserProgress) error) {
+	s.changeListeners = append(s.changeListeners, fn)
+}
+
 // GetByID retrieves a manga by ID
 func (s *Service) GetByID(id string) (*models.Manga, error) {
 	manga, err := s.repo.FindByID(id)
@@ -55,14 +70,69 @@ func (s *Service) GetAll(limit, offset int) ([]models.Manga, int, error) {
 	return mangaList, total, nil
 }
 
+// GetChanges reads a page of the catalog change feed after the since token
+func (s *Service) GetChanges(query models.MangaChangesQuery) (*models.MangaChangesPage, error) {
+	seq, err := parseChangeToken(query.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = models.DefaultMangaChangesLimit
+	}
+	if limit > models.MaxMangaChangesLimit {
+		limit = models.MaxMangaChangesLimit
+	}
+
+	// Read one extra change to learn whether another page follows
+	changes, err := s.repo.FindChangesSince(seq, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manga changes: %w", err)
+	}
+
+	page := &models.MangaChangesPage{
+		Changes:   changes,
+		NextSince: changeToken(seq),
+	}
+	if len(changes) > limit {
+		page.Changes = changes[:limit]
+		page.HasMore = true
+	}
+	if len(page.Changes) > 0 {
+		page.NextSince = page.Changes[len(page.Changes)-1].Token
+	} else {
+		page.Changes = []models.MangaChange{}
+	}
+
+	return page, nil
+}
+
+// GetAliases retrieves the alternative titles of every manga, keyed by manga ID
+func (s *Service) GetAliases() (map[string][]string, error) {
+	aliases, err := s.repo.FindAliases()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manga aliases: %w", err)
+	}
+
+	return aliases, nil
+}
+
 // GetUserLibrary retrieves a user's manga library
-func (s *Service) GetUserLibrary(userID string) ([]models.UserProgressWithManga, error) {
-	library, err := s.repo.GetUserLibrary(userID)
+func (s *Service) GetUserLibrary(userID string, filter models.LibraryQuery) ([]models.UserProgressWithManga, int, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, fmt.Errorf("invalid status: %s", filter.Status)
+	}
+	if filter.MinRating > 0 && filter.MaxRating > 0 && filter.MinRating > filter.MaxRating {
+		return nil, 0, fmt.Errorf("invalid rating range: min_rating is greater than max_rating")
+	}
+
+	library, total, err := s.repo.GetUserLibrary(userID, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user library: %w", err)
+		return nil, 0, fmt.Errorf("failed to get user library: %w", err)
 	}
 
-	return library, nil
+	return library, total, nil
 }
 
 // AddToLibrary adds a manga to user's library
@@ -83,19 +153,39 @@ func (s *Service) AddToLibrary(userID string, req models.LibraryAddRequest) erro
 	if req.CurrentChapter < 0 {
 		return fmt.Errorf("invalid chapter number: cannot be negative")
 	}
-	if manga.TotalChapters > 0 && req.CurrentChapter > manga.TotalChapters {
+	if req.CurrentChapter.Exceeds(manga.TotalChapters) {
 		return fmt.Errorf("invalid chapter number: exceeds total chapters (%d)", manga.TotalChapters)
 	}
 
+	progress := &models.UserProgress{
+		UserID:         userID,
+		MangaID:        req.MangaID,
+		CurrentChapter: req.CurrentChapter,
+		Status:         models.ReadingStatusPlanToRead,
+		Rating:         req.Rating,
+	}
+
+	// Adding a finished series without a chapter means it was read to the end
+	if req.Status == models.ReadingStatusCompleted && req.CurrentChapter == 0 {
+		progress.CurrentChapter = models.ChapterNumber(manga.TotalChapters)
+	}
+
+	// New entries start out as plan_to_read and move to the requested status
+	if err := applyStatusChange(progress, &req.Status, manga.TotalChapters, time.Now()); err != nil {
+		return err
+	}
+
 	// Add to library
-	if err := s.repo.AddToLibrary(userID, req.MangaID, req.Status, req.CurrentChapter); err != nil {
+	if err := s.repo.AddToLibrary(progress); err != nil {
 		return fmt.Errorf("failed to add manga to library: %w", err)
 	}
 
 	return nil
 }
 
-// UpdateProgress updates user's reading progress
+// UpdateProgress updates user's reading progress.
+// When req.Version is set and no longer matches the stored version, the update is
+// rejected with a *ConflictError unless req.OnConflict asks for the highest chapter to win.
 func (s *Service) UpdateProgress(userID, mangaID string, req models.ProgressUpdateRequest) error {
 	// Verify manga exists
 	manga, err := s.repo.FindByID(mangaID)
@@ -103,35 +193,45 @@ func (s *Service) UpdateProgress(userID, mangaID string, req models.ProgressUpda
 		return fmt.Errorf("manga not found")
 	}
 
-	// Get existing progress (needed for partial updates and validation)
-	existingProgress, err := s.repo.GetProgress(userID, mangaID)
-	if err != nil {
-		return fmt.Errorf("manga not in user's library")
-	}
-
-	// Determine new chapter
-	currentChapter := existingProgress.CurrentChapter
-	if req.CurrentChapter != nil {
-		currentChapter = *req.CurrentChapter
+	var previous, next models.UserProgress
+	for attempt := 1; ; attempt++ {
+		// Get existing progress (needed for partial updates and validation)
+		existingProgress, err := s.repo.GetProgress(userID, mangaID)
+		if err != nil {
+			return fmt.Errorf("manga not in user's library")
+		}
 
-		// Validate chapter number
-		if manga.TotalChapters <= 0 {
-			if currentChapter > 0 {
-				return fmt.Errorf("invalid chapter number: cannot update chapter progress when total chapters is N/A")
-			}
-		} else {
-			if currentChapter < 0 {
-				return fmt.Errorf("invalid chapter number: cannot be negative")
-			}
-			if currentChapter > manga.TotalChapters {
-				return fmt.Errorf("invalid chapter number: exceeds total chapters (%d)", manga.TotalChapters)
+		update := req
+		if req.Version != nil && *req.Version != existingProgress.Version {
+			if req.OnConflict != models.ConflictPolicyHighestChapter {
+				return &ConflictError{Requested: *req.Version, Current: existingProgress}
 			}
+			update = keepHighestChapter(req, existingProgress)
+		}
+
+		next, err = nextProgress(existingProgress, update, manga.TotalChapters)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.UpdateProgress(&next, existingProgress)
+		if err == nil {
+			previous = *existingProgress
+			break
+		}
+		if !errors.Is(err, ErrProgressConflict) {
+			return fmt.Errorf("failed to update progress: %w", err)
 		}
-	}
 
-	// Update progress
-	if err := s.repo.UpdateProgress(userID, mangaID, currentChapter, req.Status, req.Rating); err != nil {
-		return fmt.Errorf("failed to update progress: %w", err)
+		// Another write landed after existingProgress was read. A checked update
+		// now conflicts (or merges) on the next pass; an unchecked one is re-applied.
+		if attempt == maxProgressWriteAttempts {
+			current, err := s.repo.GetProgress(userID, mangaID)
+			if err != nil {
+				return fmt.Errorf("manga not in user's library")
+			}
+			return &ConflictError{Requested: existingProgress.Version, Current: current}
+		}
 	}
 
 	// Fetch username for broadcast
@@ -142,25 +242,169 @@ func (s *Service) UpdateProgress(userID, mangaID string, req models.ProgressUpda
 		}
 	}
 
-	// Trigger TCP broadcast for real-time sync
+	// Trigger TCP broadcast for real-time sync. The TCP server decides which
+	// clients may receive it from the user's privacy settings.
 	s.TCPBroadcastChan <- models.TCPProgressBroadcast{
 		UserID:         userID,
 		Username:       username,
 		MangaID:        mangaID,
 		MangaTitle:     manga.Title,
-		CurrentChapter: currentChapter,
-		Status:         derefStatus(req.Status),
+		CurrentChapter: next.CurrentChapter,
+		Status:         next.Status,
+		Version:        next.Version,
 		Timestamp:      time.Now(),
 	}
 
+	for _, listener := range s.progressListeners {
+		if err := listener(userID); err != nil {
+			log.Printf("Progress listener failed for user %s: %v", userID, err)
+		}
+	}
+	for _, listener := range s.changeListeners {
+		if err := listener(previous, next); err != nil {
+			log.Printf("Progress change listener failed for user %s: %v", userID, err)
+		}
+	}
+
 	return nil
 }
 
-func derefStatus(s *models.ReadingStatus) models.ReadingStatus {
-	if s == nil {
-		return ""
+// nextProgress applies an update to a copy of the existing progress, validating
+// the chapter and enforcing the status rules
+func nextProgress(existing *models.UserProgress, req models.ProgressUpdateRequest, totalChapters int) (models.UserProgress, error) {
+	next := *existing
+
+	if req.CurrentChapter != nil {
+		// Validate chapter number
+		// If TotalChapters is 0, the series is ongoing with an unknown count, so any chapter is accepted.
+		if *req.CurrentChapter < 0 {
+			return next, fmt.Errorf("invalid chapter number: cannot be negative")
+		}
+		if req.CurrentChapter.Exceeds(totalChapters) {
+			return next, fmt.Errorf("invalid chapter number: exceeds total chapters (%d)", totalChapters)
+		}
+		next.CurrentChapter = *req.CurrentChapter
+	}
+
+	if req.Rating != nil {
+		next.Rating = req.Rating
+	}
+
+	// Clients such as the CLI resend the current status with every update. That
+	// is no status change, so reaching the final chapter still completes the entry.
+	requested := req.Status
+	if requested != nil && *requested == existing.Status {
+		requested = nil
+	}
+
+	// Enforce status transitions and maintain started_at/completed_at
+	if err := applyStatusChange(&next, requested, totalChapters, time.Now()); err != nil {
+		return next, err
+	}
+
+	// The rating stays with the archived pass; a reread starts unrated unless rated now
+	if startsReread(existing.Status, next.Status) && req.Rating == nil {
+		next.Rating = nil
+	}
+
+	return next, nil
+}
+
+// StartReread begins a new read-through of a completed series. The finished pass
+// is archived and the entry restarts unrated at chapter 0 with status "reading".
+func (s *Service) StartReread(userID, mangaID string) error {
+	existingProgress, err := s.repo.GetProgress(userID, mangaID)
+	if err != nil {
+		return fmt.Errorf("manga not in user's library")
+	}
+
+	if existingProgress.Status != models.ReadingStatusCompleted {
+		return &TransitionError{
+			Code:    ErrCodeNotCompleted,
+			From:    existingProgress.Status,
+			To:      models.ReadingStatusReading,
+			Message: fmt.Sprintf("cannot reread a series that is %s; only completed series can be reread", existingProgress.Status),
+		}
+	}
+
+	// Pin the version checked above so a concurrent change is not overwritten
+	chapter := models.ChapterNumber(0)
+	status := models.ReadingStatusReading
+	return s.UpdateProgress(userID, mangaID, models.ProgressUpdateRequest{
+		CurrentChapter: &chapter,
+		Status:         &status,
+		Version:        &existingProgress.Version,
+	})
+}
+
+// UpdateEntry stars, prioritizes or hides a library entry and returns the updated entry
+func (s *Service) UpdateEntry(userID, mangaID string, req models.LibraryEntryUpdateRequest) (*models.UserProgress, error) {
+	progress, err := s.repo.GetProgress(userID, mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("manga not in user's library")
 	}
-	return *s
+
+	if req.Favorite != nil {
+		progress.Favorite = *req.Favorite
+	}
+	if req.Priority != nil {
+		if *req.Priority < models.PriorityNone || *req.Priority > models.PriorityHigh {
+			return nil, fmt.Errorf("invalid priority: must be between %d and %d", models.PriorityNone, models.PriorityHigh)
+		}
+		progress.Priority = *req.Priority
+	}
+	if req.Hidden != nil {
+		progress.Hidden = *req.Hidden
+	}
+
+	if err := s.repo.UpdateEntryFlags(progress); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// GetReadThroughs lists every pass through a series in the user's library,
+// oldest first. The entry's own pass is last unless it has not been started.
+func (s *Service) GetReadThroughs(userID, mangaID string) ([]models.ReadThrough, error) {
+	progress, err := s.repo.GetProgress(userID, mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("manga not in user's library")
+	}
+
+	readThroughs, err := s.repo.GetReadThroughs(userID, mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read-throughs: %w", err)
+	}
+
+	if progress.StartedAt.Valid || progress.CompletedAt.Valid || progress.CurrentChapter > 0 {
+		current := models.ReadThrough{
+			Number:  len(readThroughs) + 1,
+			Chapter: progress.CurrentChapter,
+			Rating:  progress.Rating,
+			Current: true,
+		}
+		if progress.StartedAt.Valid {
+			current.StartedAt = &progress.StartedAt.Time
+		}
+		if progress.CompletedAt.Valid {
+			current.CompletedAt = &progress.CompletedAt.Time
+		}
+		readThroughs = append(readThroughs, current)
+	}
+
+	return readThroughs, nil
+}
+
+// GetChapterSuggestions proposes total chapter counts for manga whose count is
+// unknown, from the furthest chapter readers have reached
+func (s *Service) GetChapterSuggestions() ([]models.ChapterSuggestion, error) {
+	suggestions, err := s.repo.FindChapterSuggestions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chapter suggestions: %w", err)
+	}
+
+	return suggestions, nil
 }
 
 // NotifyNotification sends a UDP notification (triggered by admin)
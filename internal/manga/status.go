@@ -0,0 +1,95 @@
+package manga
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Error codes reported when a progress update breaks the reading status rules
+const (
+	ErrCodeInvalidStatus      = "INVALID_STATUS"
+	ErrCodeInvalidTransition  = "INVALID_STATUS_TRANSITION"
+	ErrCodeChaptersIncomplete = "CHAPTERS_INCOMPLETE"
+	ErrCodeNotCompleted       = "NOT_COMPLETED"
+)
+
+// TransitionError describes a rejected reading status change
+type TransitionError struct {
+	Code    string
+	From    models.ReadingStatus
+	To      models.ReadingStatus
+	Message string
+}
+
+func (e *TransitionError) Error() string {
+	return e.Message
+}
+
+// startsReread reports whether a status change begins another pass of a
+// completed series. Moving back to plan_to_read only undoes the completion.
+func startsReread(from, to models.ReadingStatus) bool {
+	return from == models.ReadingStatusCompleted && to == models.ReadingStatusReading
+}
+
+// applyStatusChange moves progress to its next reading status and keeps
+// started_at/completed_at in step with it. requested is nil when the client did
+// not ask for a status change; reaching the final chapter then completes the entry.
+func applyStatusChange(progress *models.UserProgress, requested *models.ReadingStatus, totalChapters int, now time.Time) error {
+	from := progress.Status
+	to := from
+
+	if requested != nil {
+		to = *requested
+		if !to.IsValid() {
+			return &TransitionError{
+				Code:    ErrCodeInvalidStatus,
+				From:    from,
+				To:      to,
+				Message: fmt.Sprintf("invalid reading status: %q", to),
+			}
+		}
+	} else if progress.CurrentChapter.Reaches(totalChapters) && from.CanTransitionTo(models.ReadingStatusCompleted) {
+		to = models.ReadingStatusCompleted
+	}
+
+	if !from.CanTransitionTo(to) {
+		return &TransitionError{
+			Code:    ErrCodeInvalidTransition,
+			From:    from,
+			To:      to,
+			Message: fmt.Sprintf("cannot change status from %s to %s", from, to),
+		}
+	}
+
+	if to == models.ReadingStatusCompleted && totalChapters > 0 && !progress.CurrentChapter.Reaches(totalChapters) {
+		return &TransitionError{
+			Code:    ErrCodeChaptersIncomplete,
+			From:    from,
+			To:      to,
+			Message: fmt.Sprintf("cannot mark as completed at chapter %s of %d", progress.CurrentChapter, totalChapters),
+		}
+	}
+
+	// Leaving "completed" clears the dates of the finished pass
+	if from == models.ReadingStatusCompleted && to != models.ReadingStatusCompleted {
+		progress.StartedAt = sql.NullTime{}
+		progress.CompletedAt = sql.NullTime{}
+	}
+
+	if to == models.ReadingStatusReading && !progress.StartedAt.Valid {
+		progress.StartedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	if to == models.ReadingStatusCompleted && !progress.CompletedAt.Valid {
+		if !progress.StartedAt.Valid {
+			progress.StartedAt = sql.NullTime{Time: now, Valid: true}
+		}
+		progress.CompletedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	progress.Status = to
+	return nil
+}
@@ -48,3 +48,16 @@ func AuthMiddleware(userService *user.Service) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware authenticates requests that carry a token, like
+// AuthMiddleware, and lets anonymous requests through without a user
+func OptionalAuthMiddleware(userService *user.Service) gin.HandlerFunc {
+	authenticate := AuthMiddleware(userService)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
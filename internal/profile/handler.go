@@ -0,0 +1,80 @@
+package profile
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles public profile HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new profile handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// viewer returns the signed-in user, or nil for anonymous requests
+func viewer(c *gin.Context) *models.User {
+	if userInterface, exists := c.Get("user"); exists {
+		return userInterface.(*models.User)
+	}
+	return nil
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "user not found":
+		response.NotFound(c, "User not found")
+	case err.Error() == "library is private":
+		response.Forbidden(c, "This library is private")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// GetProfile returns a user's public profile
+// GET /users/:username
+func (h *Handler) GetProfile(c *gin.Context) {
+	profile, err := h.service.GetProfile(viewer(c), c.Param("username"))
+	if err != nil {
+		respondError(c, err, "Failed to get profile")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"profile": profile})
+}
+
+// GetLibrary returns a user's library, subject to their privacy settings
+// GET /users/:username/library
+func (h *Handler) GetLibrary(c *gin.Context) {
+	var filter models.LibraryQuery
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	library, total, err := h.service.GetLibrary(viewer(c), c.Param("username"), filter)
+	if err != nil {
+		respondError(c, err, "Failed to get library")
+		return
+	}
+
+	if filter.Limit > 0 {
+		response.Paginated(c, library, total, filter.Limit, filter.Offset)
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": library}, &response.Meta{
+		Total: total,
+		Count: len(library),
+	})
+}
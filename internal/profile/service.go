@@ -0,0 +1,150 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// FollowerCheck reports whether followerID follows userID
+type FollowerCheck func(followerID, userID string) (bool, error)
+
+// AchievementLookup returns the achievements a user has unlocked
+type AchievementLookup func(userID string) ([]models.UnlockedAchievement, error)
+
+// Service handles public profile business logic
+type Service struct {
+	userService  *user.Service
+	mangaService *manga.Service
+	isFollower   FollowerCheck
+	achievements AchievementLookup
+}
+
+// NewService creates a new profile service
+func NewService(userService *user.Service, mangaService *manga.Service) *Service {
+	return &Service{userService: userService, mangaService: mangaService}
+}
+
+// UseFollowerCheck sets how followers are recognized for followers-only profiles.
+// Without one, followers-only profiles are visible to their owner alone.
+func (s *Service) UseFollowerCheck(fn FollowerCheck) {
+	s.isFollower = fn
+}
+
+// UseAchievementLookup sets where the achievements shown on profiles come from.
+// Without one, profiles list no achievements.
+func (s *Service) UseAchievementLookup(fn AchievementLookup) {
+	s.achievements = fn
+}
+
+// CanView reports whether viewer (nil when anonymous) may see the owner's
+// library and reading activity
+func (s *Service) CanView(viewer, owner *models.User) (bool, error) {
+	if viewer != nil && viewer.ID == owner.ID {
+		return true, nil
+	}
+
+	switch owner.Visibility {
+	case models.ProfileVisibilityPublic:
+		return true, nil
+	case models.ProfileVisibilityFollowers:
+		if viewer == nil || s.isFollower == nil {
+			return false, nil
+		}
+		return s.isFollower(viewer.ID, owner.ID)
+	default:
+		return false, nil
+	}
+}
+
+// GetProfile returns the public profile of a user as seen by viewer
+func (s *Service) GetProfile(viewer *models.User, username string) (*models.PublicProfile, error) {
+	owner, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.PublicProfile{
+		Username:   owner.Username,
+		Visibility: owner.Visibility,
+		JoinedAt:   owner.CreatedAt,
+	}
+
+	allowed, err := s.CanView(viewer, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check profile access: %w", err)
+	}
+	if !allowed {
+		profile.Restricted = true
+		return profile, nil
+	}
+
+	library, _, err := s.mangaService.GetUserLibrary(owner.ID, models.LibraryQuery{ExcludeHidden: viewer == nil || viewer.ID != owner.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.LibrarySummary{ByStatus: map[models.ReadingStatus]int{}}
+	for _, item := range library {
+		summary.Total++
+		summary.ByStatus[item.Status]++
+		if item.Favorite {
+			summary.Favorites++
+		}
+	}
+	profile.Library = summary
+
+	if s.achievements != nil {
+		achievements, err := s.achievements(owner.ID)
+		if err != nil {
+			return nil, err
+		}
+		profile.Achievements = achievements
+	}
+
+	return profile, nil
+}
+
+// GetLibrary returns a user's library as seen by viewer. Other users never see
+// hidden entries, private shelves or tags; they can filter by public shelves only.
+func (s *Service) GetLibrary(viewer *models.User, username string, filter models.LibraryQuery) ([]models.UserProgressWithManga, int, error) {
+	owner, err := s.userService.GetByUsername(username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	allowed, err := s.CanView(viewer, owner)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check profile access: %w", err)
+	}
+	if !allowed {
+		return nil, 0, fmt.Errorf("library is private")
+	}
+
+	own := viewer != nil && viewer.ID == owner.ID
+	if !own {
+		if filter.Tag != "" {
+			return nil, 0, fmt.Errorf("invalid filter: tag filters only apply to your own library")
+		}
+		filter.ExcludeHidden = true
+		filter.PublicShelvesOnly = true
+	}
+
+	library, total, err := s.mangaService.GetUserLibrary(owner.ID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !own {
+		for i := range library {
+			library[i].Tags = []string{}
+		}
+	}
+	if library == nil {
+		library = []models.UserProgressWithManga{}
+	}
+
+	return library, total, nil
+}
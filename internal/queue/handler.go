@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles reading queue and up-next HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new reading queue handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "manga not in user's library":
+		response.NotFound(c, "Manga not in library")
+	case err.Error() == "manga not queued":
+		response.NotFound(c, "Manga not in queue")
+	case err.Error() == "manga already queued":
+		response.Conflict(c, "Manga already in queue")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// List returns the user's reading queue
+// GET /users/queue
+func (h *Handler) List(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.service.List(user.ID)
+	if err != nil {
+		response.InternalError(c, "Failed to list reading queue")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": entries}, &response.Meta{
+		Count: len(entries),
+	})
+}
+
+// Add puts a library entry in the reading queue
+// POST /users/queue
+func (h *Handler) Add(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.QueueAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	entries, err := h.service.Add(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to add manga to queue")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"items": entries})
+}
+
+// Reorder sets the order of the reading queue
+// PUT /users/queue/order
+func (h *Handler) Reorder(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.QueueOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	entries, err := h.service.Reorder(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to reorder queue")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"items": entries})
+}
+
+// Remove takes a manga out of the reading queue
+// DELETE /users/queue/:manga_id
+func (h *Handler) Remove(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Remove(user.ID, c.Param("manga_id")); err != nil {
+		respondError(c, err, "Failed to remove manga from queue")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Manga removed from queue"})
+}
+
+// UpNext suggests what to read next
+// GET /users/library/next
+func (h *Handler) UpNext(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var query models.UpNextQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	entries, err := h.service.UpNext(user.ID, query)
+	if err != nil {
+		response.InternalError(c, "Failed to get up next")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": entries}, &response.Meta{
+		Count: len(entries),
+	})
+}
@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Repository handles reading queue data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new reading queue repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// queuedEntry is a row of the reading queue
+type queuedEntry struct {
+	MangaID  string
+	Position int
+	AddedAt  time.Time
+}
+
+// List returns the user's queued manga in queue order
+func (r *Repository) List(userID string) ([]queuedEntry, error) {
+	rows, err := r.db.Query(`
+		SELECT manga_id, position, added_at
+		FROM reading_queue
+		WHERE user_id = ?
+		ORDER BY position ASC, added_at ASC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reading queue: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []queuedEntry
+	for rows.Next() {
+		var entry queuedEntry
+		if err := rows.Scan(&entry.MangaID, &entry.Position, &entry.AddedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// EntryExists reports whether the manga is in the user's library
+func (r *Repository) EntryExists(userID, mangaID string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM user_progress WHERE user_id = ? AND manga_id = ?`, userID, mangaID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check library entry: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Insert places a library entry in the queue at position, moving later entries back.
+// A position past the end of the queue appends the entry.
+func (r *Repository) Insert(userID, mangaID string, position int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var queued int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM reading_queue WHERE user_id = ? AND manga_id = ?`, userID, mangaID).Scan(&queued)
+	if err != nil {
+		return fmt.Errorf("failed to check reading queue: %w", err)
+	}
+	if queued > 0 {
+		return fmt.Errorf("manga already queued")
+	}
+
+	var size int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM reading_queue WHERE user_id = ?`, userID).Scan(&size); err != nil {
+		return fmt.Errorf("failed to count reading queue: %w", err)
+	}
+	if position > size {
+		position = size
+	}
+
+	_, err = tx.Exec(`UPDATE reading_queue SET position = position + 1 WHERE user_id = ? AND position >= ?`, userID, position)
+	if err != nil {
+		return fmt.Errorf("failed to make room in reading queue: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO reading_queue (user_id, manga_id, position, added_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+	`, userID, mangaID, position)
+	if err != nil {
+		return fmt.Errorf("failed to add manga to reading queue: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Remove takes a manga out of the queue, moving later entries forward
+func (r *Repository) Remove(userID, mangaID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var position int
+	err = tx.QueryRow(`SELECT position FROM reading_queue WHERE user_id = ? AND manga_id = ?`, userID, mangaID).Scan(&position)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("manga not queued")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find queue entry: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM reading_queue WHERE user_id = ? AND manga_id = ?`, userID, mangaID); err != nil {
+		return fmt.Errorf("failed to remove manga from reading queue: %w", err)
+	}
+
+	_, err = tx.Exec(`UPDATE reading_queue SET position = position - 1 WHERE user_id = ? AND position > ?`, userID, position)
+	if err != nil {
+		return fmt.Errorf("failed to close gap in reading queue: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Reorder assigns positions to the given queued manga in list order
+func (r *Repository) Reorder(userID string, mangaIDs []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for position, mangaID := range mangaIDs {
+		result, err := tx.Exec(`
+			UPDATE reading_queue SET position = ?
+			WHERE user_id = ? AND manga_id = ?
+		`, position, userID, mangaID)
+		if err != nil {
+			return fmt.Errorf("failed to reorder reading queue: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			return fmt.Errorf("manga not queued")
+		}
+	}
+
+	return tx.Commit()
+}
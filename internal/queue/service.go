@@ -0,0 +1,225 @@
+package queue
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Service handles reading queue and up-next business logic
+type Service struct {
+	repo         *Repository
+	mangaService *manga.Service
+}
+
+// NewService creates a new reading queue service
+func NewService(repo *Repository, mangaService *manga.Service) *Service {
+	return &Service{repo: repo, mangaService: mangaService}
+}
+
+// List returns the user's reading queue in order
+func (s *Service) List(userID string) ([]models.QueueEntry, error) {
+	queued, err := s.repo.List(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	library, err := s.library(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]models.QueueEntry, 0, len(queued))
+	for i, q := range queued {
+		item, ok := library[q.MangaID]
+		if !ok {
+			continue
+		}
+		entries = append(entries, models.QueueEntry{
+			UserProgressWithManga: item,
+			Position:              i,
+			AddedAt:               q.AddedAt,
+		})
+	}
+
+	return entries, nil
+}
+
+// Add puts a library entry in the queue and returns the updated queue
+func (s *Service) Add(userID string, req models.QueueAddRequest) ([]models.QueueEntry, error) {
+	exists, err := s.repo.EntryExists(userID, req.MangaID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("manga not in user's library")
+	}
+
+	position := math.MaxInt
+	if req.Position != nil {
+		position = *req.Position
+	}
+
+	if err := s.repo.Insert(userID, req.MangaID, position); err != nil {
+		return nil, err
+	}
+
+	return s.List(userID)
+}
+
+// Remove takes a manga out of the queue
+func (s *Service) Remove(userID, mangaID string) error {
+	return s.repo.Remove(userID, mangaID)
+}
+
+// Reorder sets the order of the queue. Every queued manga must be listed exactly once.
+func (s *Service) Reorder(userID string, req models.QueueOrderRequest) ([]models.QueueEntry, error) {
+	queued, err := s.repo.List(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(req.MangaIDs))
+	for _, id := range req.MangaIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("invalid queue order: duplicate manga %s", id)
+		}
+		seen[id] = true
+	}
+	for _, q := range queued {
+		if !seen[q.MangaID] {
+			return nil, fmt.Errorf("invalid queue order: missing manga %s", q.MangaID)
+		}
+	}
+
+	if err := s.repo.Reorder(userID, req.MangaIDs); err != nil {
+		return nil, err
+	}
+
+	return s.List(userID)
+}
+
+// UpNext suggests what the user should read next, best first
+func (s *Service) UpNext(userID string, query models.UpNextQuery) ([]models.UpNextEntry, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = models.DefaultUpNextLimit
+	}
+	if limit > models.MaxUpNextLimit {
+		limit = models.MaxUpNextLimit
+	}
+
+	queued, err := s.repo.List(userID)
+	if err != nil {
+		return nil, err
+	}
+	queuedIDs := make([]string, len(queued))
+	for i, q := range queued {
+		queuedIDs[i] = q.MangaID
+	}
+
+	library, _, err := s.mangaService.GetUserLibrary(userID, models.LibraryQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	return RankUpNext(library, queuedIDs, limit), nil
+}
+
+// RankUpNext orders library entries into an up-next list of at most limit entries.
+//
+// Queued entries come first, in queue order, unless they are completed or dropped.
+// The rest of the list is made of entries that are reading or planned and still
+// have chapters left (or an unknown chapter count), ranked by:
+//  1. priority, highest first
+//  2. favorites before other entries
+//  3. unread chapters, fewest first so nearly finished series are wrapped up;
+//     entries with an unknown chapter count follow those with a known one
+//  4. most recently updated first
+func RankUpNext(library []models.UserProgressWithManga, queuedIDs []string, limit int) []models.UpNextEntry {
+	byID := make(map[string]models.UserProgressWithManga, len(library))
+	for _, item := range library {
+		byID[item.MangaID] = item
+	}
+
+	entries := []models.UpNextEntry{}
+	inQueue := make(map[string]bool, len(queuedIDs))
+	for _, id := range queuedIDs {
+		inQueue[id] = true
+		item, ok := byID[id]
+		if !ok || item.Status == models.ReadingStatusCompleted || item.Status == models.ReadingStatusDropped {
+			continue
+		}
+		entries = append(entries, upNextEntry(item, true))
+	}
+
+	var candidates []models.UpNextEntry
+	for _, item := range library {
+		if inQueue[item.MangaID] {
+			continue
+		}
+		if item.Status != models.ReadingStatusReading && item.Status != models.ReadingStatusPlanToRead {
+			continue
+		}
+		entry := upNextEntry(item, false)
+		if entry.UnreadChapters != nil && *entry.UnreadChapters == 0 {
+			continue
+		}
+		candidates = append(candidates, entry)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if a.Favorite != b.Favorite {
+			return a.Favorite
+		}
+		if (a.UnreadChapters == nil) != (b.UnreadChapters == nil) {
+			return a.UnreadChapters != nil
+		}
+		if a.UnreadChapters != nil && *a.UnreadChapters != *b.UnreadChapters {
+			return *a.UnreadChapters < *b.UnreadChapters
+		}
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.After(b.UpdatedAt)
+		}
+		return a.MangaID < b.MangaID
+	})
+
+	entries = append(entries, candidates...)
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+
+	return entries
+}
+
+func upNextEntry(item models.UserProgressWithManga, queued bool) models.UpNextEntry {
+	return models.UpNextEntry{
+		UserProgressWithManga: item,
+		Queued:                queued,
+		UnreadChapters:        item.CurrentChapter.UnreadOf(item.Manga.TotalChapters),
+	}
+}
+
+// library returns the user's library entries keyed by manga ID
+func (s *Service) library(userID string) (map[string]models.UserProgressWithManga, error) {
+	items, _, err := s.mangaService.GetUserLibrary(userID, models.LibraryQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	library := make(map[string]models.UserProgressWithManga, len(items))
+	for _, item := range items {
+		library[item.MangaID] = item
+	}
+	return library, nil
+}
@@ -0,0 +1,183 @@
+package review
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles review HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new review handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "review not found":
+		response.NotFound(c, "Review not found")
+	case err.Error() == "manga not found":
+		response.NotFound(c, "Manga not found")
+	case err.Error() == "manga not in user's library":
+		response.NotFound(c, "Manga not in library")
+	case err.Error() == "vote not found":
+		response.NotFound(c, "Vote not found")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// ListForManga returns the reviews of a manga the viewer may see
+// GET /manga/:id/reviews?sort=newest|helpful&limit=20&offset=0
+func (h *Handler) ListForManga(c *gin.Context) {
+	var query models.ReviewQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters")
+		return
+	}
+
+	// The route accepts an optional token, so readers see their own reviews
+	// and those of followers-only users they follow
+	viewerID := ""
+	if userInterface, exists := c.Get("user"); exists {
+		viewerID = userInterface.(*models.User).ID
+	}
+
+	reviews, total, err := h.service.ListForManga(viewerID, c.Param("id"), query)
+	if err != nil {
+		respondError(c, err, "Failed to list reviews")
+		return
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = defaultReviewLimit
+	}
+	response.Paginated(c, reviews, total, query.Limit, query.Offset)
+}
+
+// ListMine returns the authenticated user's reviews
+// GET /users/reviews
+func (h *Handler) ListMine(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	reviews, err := h.service.ListForUser(user.ID)
+	if err != nil {
+		respondError(c, err, "Failed to list reviews")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": reviews}, &response.Meta{
+		Total: len(reviews),
+		Count: len(reviews),
+	})
+}
+
+// Get returns the user's review of a library entry
+// GET /users/library/:manga_id/review
+func (h *Handler) Get(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.service.Get(user.ID, c.Param("manga_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get review")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"review": review})
+}
+
+// Write creates or replaces the user's review of a library entry
+// PUT /users/library/:manga_id/review
+func (h *Handler) Write(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	review, created, err := h.service.Write(user.ID, c.Param("manga_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to save review")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	response.Success(c, status, gin.H{"review": review})
+}
+
+// Delete removes the user's review of a library entry
+// DELETE /users/library/:manga_id/review
+func (h *Handler) Delete(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(user.ID, c.Param("manga_id")); err != nil {
+		respondError(c, err, "Failed to delete review")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Review deleted"})
+}
+
+// MarkHelpful records the user's helpful vote on a review
+// POST /users/reviews/:review_id/helpful
+func (h *Handler) MarkHelpful(c *gin.Context) {
+	h.vote(c, true)
+}
+
+// UnmarkHelpful withdraws the user's helpful vote on a review
+// DELETE /users/reviews/:review_id/helpful
+func (h *Handler) UnmarkHelpful(c *gin.Context) {
+	h.vote(c, false)
+}
+
+func (h *Handler) vote(c *gin.Context, helpful bool) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.service.Vote(user.ID, c.Param("review_id"), helpful)
+	if err != nil {
+		respondError(c, err, "Failed to update vote")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"review": review})
+}
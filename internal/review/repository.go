@@ -0,0 +1,231 @@
+package review
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles review data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new review repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const reviewSelectFields = `
+	r.id, r.user_id, u.username, r.manga_id, m.title, r.body, r.spoiler, up.rating,
+	(SELECT COUNT(*) FROM review_votes v WHERE v.review_id = r.id) AS helpful_count,
+	r.created_at, r.updated_at
+`
+
+const reviewJoins = `
+	FROM reviews r
+	JOIN users u ON u.id = r.user_id
+	JOIN manga m ON m.id = r.manga_id
+	JOIN user_progress up ON up.user_id = r.user_id AND up.manga_id = r.manga_id
+`
+
+// reviewVisible keeps the reviews the viewer may see, as profiles do: those on
+// hidden library entries or by private users only to their author, and those
+// by followers-only users to their followers too
+const reviewVisible = `
+	(r.user_id = ? OR (
+		up.hidden = 0 AND (
+			u.visibility = 'public'
+			OR (u.visibility = 'followers' AND EXISTS (
+				SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = r.user_id
+			))
+		)
+	))
+`
+
+func scanReview(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*models.Review, error) {
+	var review models.Review
+	var rating sql.NullInt64
+	err := scanner.Scan(
+		&review.ID,
+		&review.UserID,
+		&review.Username,
+		&review.MangaID,
+		&review.MangaTitle,
+		&review.Body,
+		&review.Spoiler,
+		&rating,
+		&review.HelpfulCount,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if rating.Valid {
+		r := int(rating.Int64)
+		review.Rating = &r
+	}
+	return &review, nil
+}
+
+// EntryExists reports whether the manga is in the user's library
+func (r *Repository) EntryExists(userID, mangaID string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM user_progress WHERE user_id = ? AND manga_id = ?`, userID, mangaID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check library entry: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Upsert writes the user's review of a manga, replacing any earlier one.
+// It returns true when a new review was created.
+func (r *Repository) Upsert(review *models.Review) (bool, error) {
+	var exists int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM reviews WHERE user_id = ? AND manga_id = ?`, review.UserID, review.MangaID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check review: %w", err)
+	}
+
+	query := `
+		INSERT INTO reviews (id, user_id, manga_id, body, spoiler, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT(user_id, manga_id) DO UPDATE SET
+			body = excluded.body,
+			spoiler = excluded.spoiler,
+			updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := r.db.Exec(query, review.ID, review.UserID, review.MangaID, review.Body, review.Spoiler); err != nil {
+		return false, fmt.Errorf("failed to save review: %w", err)
+	}
+	return exists == 0, nil
+}
+
+// FindByEntry finds the user's review of a manga
+func (r *Repository) FindByEntry(userID, mangaID string) (*models.Review, error) {
+	query := fmt.Sprintf(`SELECT %s %s WHERE r.user_id = ? AND r.manga_id = ?`, reviewSelectFields, reviewJoins)
+
+	review, err := scanReview(r.db.QueryRow(query, userID, mangaID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("review not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find review: %w", err)
+	}
+	return review, nil
+}
+
+// FindByID finds a review by ID
+func (r *Repository) FindByID(reviewID string) (*models.Review, error) {
+	query := fmt.Sprintf(`SELECT %s %s WHERE r.id = ?`, reviewSelectFields, reviewJoins)
+
+	review, err := scanReview(r.db.QueryRow(query, reviewID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("review not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find review: %w", err)
+	}
+	return review, nil
+}
+
+// ListByManga returns a page of the manga's reviews visible to viewerID (empty
+// for anonymous viewers) and the total number of them
+func (r *Repository) ListByManga(viewerID, mangaID string, query models.ReviewQuery) ([]models.Review, int, error) {
+	var total int
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) %s WHERE r.manga_id = ? AND %s`, reviewJoins, reviewVisible)
+	if err := r.db.QueryRow(countQuery, mangaID, viewerID, viewerID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	orderBy := "r.created_at DESC, r.id ASC"
+	if query.Sort == models.ReviewSortHelpful {
+		orderBy = "helpful_count DESC, r.created_at DESC, r.id ASC"
+	}
+
+	reviews, err := r.queryReviews(
+		fmt.Sprintf(`SELECT %s %s WHERE r.manga_id = ? AND %s ORDER BY %s LIMIT ? OFFSET ?`, reviewSelectFields, reviewJoins, reviewVisible, orderBy),
+		mangaID, viewerID, viewerID, query.Limit, query.Offset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+// ListByUser returns the user's reviews, newest first
+func (r *Repository) ListByUser(userID string) ([]models.Review, error) {
+	return r.queryReviews(
+		fmt.Sprintf(`SELECT %s %s WHERE r.user_id = ? ORDER BY r.updated_at DESC, r.id ASC`, reviewSelectFields, reviewJoins),
+		userID,
+	)
+}
+
+func (r *Repository) queryReviews(query string, args ...interface{}) ([]models.Review, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := []models.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, *review)
+	}
+
+	return reviews, rows.Err()
+}
+
+// Delete removes the user's review of a manga
+func (r *Repository) Delete(userID, mangaID string) error {
+	result, err := r.db.Exec(`DELETE FROM reviews WHERE user_id = ? AND manga_id = ?`, userID, mangaID)
+	if err != nil {
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("review not found")
+	}
+	return nil
+}
+
+// AddVote records a helpful vote (no-op if the user already voted)
+func (r *Repository) AddVote(reviewID, userID string) error {
+	query := `
+		INSERT OR IGNORE INTO review_votes (review_id, user_id, created_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+	`
+	if _, err := r.db.Exec(query, reviewID, userID); err != nil {
+		return fmt.Errorf("failed to record vote: %w", err)
+	}
+	return nil
+}
+
+// RemoveVote withdraws a helpful vote
+func (r *Repository) RemoveVote(reviewID, userID string) error {
+	result, err := r.db.Exec(`DELETE FROM review_votes WHERE review_id = ? AND user_id = ?`, reviewID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove vote: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("vote not found")
+	}
+	return nil
+}
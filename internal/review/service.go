@@ -0,0 +1,124 @@
+package review
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const defaultReviewLimit = 20
+
+// Service handles review business logic
+type Service struct {
+	repo            *Repository
+	mangaService    *manga.Service
+	createListeners []func(review *models.Review) error
+}
+
+// NewService creates a new review service
+func NewService(repo *Repository, mangaService *manga.Service) *Service {
+	return &Service{repo: repo, mangaService: mangaService}
+}
+
+// OnReviewCreated registers fn to run after a user writes their first review of a manga
+func (s *Service) OnReviewCreated(fn func(review *models.Review) error) {
+	s.createListeners = append(s.createListeners, fn)
+}
+
+// Write creates or replaces the user's review of a manga in their library.
+// It returns true when a new review was created.
+func (s *Service) Write(userID, mangaID string, req models.ReviewRequest) (*models.Review, bool, error) {
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, false, fmt.Errorf("invalid review: body cannot be empty")
+	}
+
+	inLibrary, err := s.repo.EntryExists(userID, mangaID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !inLibrary {
+		return nil, false, fmt.Errorf("manga not in user's library")
+	}
+
+	created, err := s.repo.Upsert(&models.Review{
+		ID:      uuid.New().String(),
+		UserID:  userID,
+		MangaID: mangaID,
+		Body:    body,
+		Spoiler: req.Spoiler,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	review, err := s.repo.FindByEntry(userID, mangaID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if created {
+		for _, listener := range s.createListeners {
+			if err := listener(review); err != nil {
+				log.Printf("Review listener failed for user %s: %v", userID, err)
+			}
+		}
+	}
+
+	return review, created, nil
+}
+
+// Get returns the user's review of a manga
+func (s *Service) Get(userID, mangaID string) (*models.Review, error) {
+	return s.repo.FindByEntry(userID, mangaID)
+}
+
+// Delete removes the user's review of a manga
+func (s *Service) Delete(userID, mangaID string) error {
+	return s.repo.Delete(userID, mangaID)
+}
+
+// ListForManga returns a page of a manga's reviews as seen by viewerID (empty
+// for anonymous viewers)
+func (s *Service) ListForManga(viewerID, mangaID string, query models.ReviewQuery) ([]models.Review, int, error) {
+	if _, err := s.mangaService.GetByID(mangaID); err != nil {
+		return nil, 0, err
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = defaultReviewLimit
+	}
+
+	return s.repo.ListByManga(viewerID, mangaID, query)
+}
+
+// ListForUser returns the user's own reviews
+func (s *Service) ListForUser(userID string) ([]models.Review, error) {
+	return s.repo.ListByUser(userID)
+}
+
+// Vote marks (helpful=true) or unmarks a review as helpful on behalf of userID
+func (s *Service) Vote(userID, reviewID string, helpful bool) (*models.Review, error) {
+	review, err := s.repo.FindByID(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID == userID {
+		return nil, fmt.Errorf("invalid vote: cannot vote on your own review")
+	}
+
+	if helpful {
+		err = s.repo.AddVote(reviewID, userID)
+	} else {
+		err = s.repo.RemoveVote(reviewID, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindByID(reviewID)
+}
@@ -0,0 +1,247 @@
+package shelf
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/pkg/models"
+	"github.com/tnphucccc/mangahub/pkg/response"
+)
+
+// Handler handles shelf and tag HTTP requests
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new shelf handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// currentUser returns the authenticated user set by the auth middleware
+func currentUser(c *gin.Context) (*models.User, bool) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return nil, false
+	}
+	return userInterface.(*models.User), true
+}
+
+// respondError maps service errors to HTTP responses
+func respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case err.Error() == "shelf not found":
+		response.NotFound(c, "Shelf not found")
+	case err.Error() == "manga not in user's library":
+		response.NotFound(c, "Manga not in library")
+	case err.Error() == "manga not on shelf":
+		response.NotFound(c, "Manga not on shelf")
+	case err.Error() == "shelf name already exists":
+		response.Conflict(c, "Shelf name already exists")
+	case strings.HasPrefix(err.Error(), "invalid "):
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalError(c, fallback)
+	}
+}
+
+// List returns the user's shelves
+// GET /users/shelves
+func (h *Handler) List(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	shelves, err := h.service.List(user.ID)
+	if err != nil {
+		response.InternalError(c, "Failed to list shelves")
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, gin.H{"items": shelves}, &response.Meta{
+		Count: len(shelves),
+	})
+}
+
+// Get returns a single shelf
+// GET /users/shelves/:shelf_id
+func (h *Handler) Get(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	shelf, err := h.service.Get(user.ID, c.Param("shelf_id"))
+	if err != nil {
+		respondError(c, err, "Failed to get shelf")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"shelf": shelf})
+}
+
+// Create creates a shelf
+// POST /users/shelves
+func (h *Handler) Create(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ShelfCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	shelf, err := h.service.Create(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to create shelf")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"shelf": shelf})
+}
+
+// Update renames, moves or changes the visibility of a shelf
+// PUT /users/shelves/:shelf_id
+func (h *Handler) Update(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ShelfUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	shelf, err := h.service.Update(user.ID, c.Param("shelf_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to update shelf")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"shelf": shelf})
+}
+
+// Delete removes a shelf
+// DELETE /users/shelves/:shelf_id
+func (h *Handler) Delete(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(user.ID, c.Param("shelf_id")); err != nil {
+		respondError(c, err, "Failed to delete shelf")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Shelf deleted"})
+}
+
+// Reorder sets the display order of the user's shelves
+// PUT /users/shelves/order
+func (h *Handler) Reorder(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ShelfOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	shelves, err := h.service.Reorder(user.ID, req)
+	if err != nil {
+		respondError(c, err, "Failed to reorder shelves")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"items": shelves})
+}
+
+// AddEntry puts a library entry on a shelf
+// POST /users/shelves/:shelf_id/entries
+func (h *Handler) AddEntry(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.ShelfEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	if err := h.service.AddEntry(user.ID, c.Param("shelf_id"), req.MangaID); err != nil {
+		respondError(c, err, "Failed to add manga to shelf")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"message": "Manga added to shelf"})
+}
+
+// RemoveEntry takes a library entry off a shelf
+// DELETE /users/shelves/:shelf_id/entries/:manga_id
+func (h *Handler) RemoveEntry(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveEntry(user.ID, c.Param("shelf_id"), c.Param("manga_id")); err != nil {
+		respondError(c, err, "Failed to remove manga from shelf")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Manga removed from shelf"})
+}
+
+// ListTags returns the user's tags with usage counts
+// GET /users/tags
+func (h *Handler) ListTags(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.service.ListTags(user.ID)
+	if err != nil {
+		response.InternalError(c, "Failed to list tags")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"items": tags})
+}
+
+// SetTags replaces the tags on a library entry
+// PUT /users/library/:manga_id/tags
+func (h *Handler) SetTags(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req models.TagsUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	tags, err := h.service.SetTags(user.ID, c.Param("manga_id"), req)
+	if err != nil {
+		respondError(c, err, "Failed to update tags")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"tags": tags})
+}
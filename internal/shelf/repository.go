@@ -0,0 +1,283 @@
+package shelf
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Repository handles shelf and tag data access
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new shelf repository
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const shelfSelectFields = `
+	s.id, s.user_id, s.name, s.position, s.visibility, s.created_at, s.updated_at,
+	(SELECT COUNT(*) FROM shelf_entries se WHERE se.shelf_id = s.id) AS entry_count
+`
+
+func scanShelf(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*models.Shelf, error) {
+	var shelf models.Shelf
+	err := scanner.Scan(
+		&shelf.ID,
+		&shelf.UserID,
+		&shelf.Name,
+		&shelf.Position,
+		&shelf.Visibility,
+		&shelf.CreatedAt,
+		&shelf.UpdatedAt,
+		&shelf.EntryCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &shelf, nil
+}
+
+// Create inserts a new shelf
+func (r *Repository) Create(shelf *models.Shelf) error {
+	query := `
+		INSERT INTO shelves (id, user_id, name, position, visibility, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`
+	_, err := r.db.Exec(query, shelf.ID, shelf.UserID, shelf.Name, shelf.Position, shelf.Visibility)
+	if err != nil {
+		return fmt.Errorf("failed to create shelf: %w", err)
+	}
+	return nil
+}
+
+// NextPosition returns the position after the user's last shelf
+func (r *Repository) NextPosition(userID string) (int, error) {
+	var next int
+	err := r.db.QueryRow(`SELECT COALESCE(MAX(position) + 1, 0) FROM shelves WHERE user_id = ?`, userID).Scan(&next)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next shelf position: %w", err)
+	}
+	return next, nil
+}
+
+// FindByID finds one of the user's shelves by ID
+func (r *Repository) FindByID(userID, shelfID string) (*models.Shelf, error) {
+	query := fmt.Sprintf(`SELECT %s FROM shelves s WHERE s.user_id = ? AND s.id = ?`, shelfSelectFields)
+
+	shelf, err := scanShelf(r.db.QueryRow(query, userID, shelfID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("shelf not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shelf: %w", err)
+	}
+	return shelf, nil
+}
+
+// FindByName finds one of the user's shelves by name (case-insensitive)
+func (r *Repository) FindByName(userID, name string) (*models.Shelf, error) {
+	query := fmt.Sprintf(`SELECT %s FROM shelves s WHERE s.user_id = ? AND LOWER(s.name) = LOWER(?)`, shelfSelectFields)
+
+	shelf, err := scanShelf(r.db.QueryRow(query, userID, name))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("shelf not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shelf: %w", err)
+	}
+	return shelf, nil
+}
+
+// ListByUser returns the user's shelves in display order
+func (r *Repository) ListByUser(userID string) ([]models.Shelf, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM shelves s
+		WHERE s.user_id = ?
+		ORDER BY s.position ASC, s.name ASC
+	`, shelfSelectFields)
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shelves: %w", err)
+	}
+	defer rows.Close()
+
+	shelves := []models.Shelf{}
+	for rows.Next() {
+		shelf, err := scanShelf(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan shelf: %w", err)
+		}
+		shelves = append(shelves, *shelf)
+	}
+
+	return shelves, rows.Err()
+}
+
+// Update saves a shelf's name, position and visibility
+func (r *Repository) Update(shelf *models.Shelf) error {
+	query := `
+		UPDATE shelves
+		SET name = ?, position = ?, visibility = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND user_id = ?
+	`
+	result, err := r.db.Exec(query, shelf.Name, shelf.Position, shelf.Visibility, shelf.ID, shelf.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update shelf: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("shelf not found")
+	}
+
+	return nil
+}
+
+// Delete removes a shelf; its memberships are removed by cascade
+func (r *Repository) Delete(userID, shelfID string) error {
+	result, err := r.db.Exec(`DELETE FROM shelves WHERE id = ? AND user_id = ?`, shelfID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete shelf: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("shelf not found")
+	}
+
+	return nil
+}
+
+// Reorder assigns positions to the given shelves in list order
+func (r *Repository) Reorder(userID string, shelfIDs []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for position, shelfID := range shelfIDs {
+		result, err := tx.Exec(`
+			UPDATE shelves SET position = ?, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND user_id = ?
+		`, position, shelfID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to reorder shelves: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			return fmt.Errorf("shelf not found")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// EntryExists reports whether the manga is in the user's library
+func (r *Repository) EntryExists(userID, mangaID string) (bool, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM user_progress WHERE user_id = ? AND manga_id = ?`, userID, mangaID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check library entry: %w", err)
+	}
+	return count > 0, nil
+}
+
+// AddEntry places a library entry on a shelf (no-op if already there)
+func (r *Repository) AddEntry(shelfID, userID, mangaID string) error {
+	query := `
+		INSERT OR IGNORE INTO shelf_entries (shelf_id, user_id, manga_id, added_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+	`
+	if _, err := r.db.Exec(query, shelfID, userID, mangaID); err != nil {
+		return fmt.Errorf("failed to add entry to shelf: %w", err)
+	}
+	return nil
+}
+
+// RemoveEntry takes a library entry off a shelf
+func (r *Repository) RemoveEntry(shelfID, mangaID string) error {
+	result, err := r.db.Exec(`DELETE FROM shelf_entries WHERE shelf_id = ? AND manga_id = ?`, shelfID, mangaID)
+	if err != nil {
+		return fmt.Errorf("failed to remove entry from shelf: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("manga not on shelf")
+	}
+
+	return nil
+}
+
+// SetTags replaces all tags on a library entry
+func (r *Repository) SetTags(userID, mangaID string, tags []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM library_tags WHERE user_id = ? AND manga_id = ?`, userID, mangaID); err != nil {
+		return fmt.Errorf("failed to clear tags: %w", err)
+	}
+
+	for _, tag := range tags {
+		_, err := tx.Exec(`
+			INSERT INTO library_tags (user_id, manga_id, tag, created_at)
+			VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+		`, userID, mangaID, tag)
+		if err != nil {
+			return fmt.Errorf("failed to add tag %q: %w", tag, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListTags returns every tag the user has used with its number of entries
+func (r *Repository) ListTags(userID string) ([]models.TagCount, error) {
+	rows, err := r.db.Query(`
+		SELECT tag, COUNT(*)
+		FROM library_tags
+		WHERE user_id = ?
+		GROUP BY tag
+		ORDER BY COUNT(*) DESC, tag ASC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []models.TagCount{}
+	for rows.Next() {
+		var tc models.TagCount
+		if err := rows.Scan(&tc.Tag, &tc.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tc)
+	}
+
+	return tags, rows.Err()
+}
+
+// isUniqueViolation reports whether err comes from a UNIQUE constraint
+func isUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
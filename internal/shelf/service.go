@@ -0,0 +1,218 @@
+package shelf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+const (
+	maxTagsPerEntry = 20
+	maxTagLength    = 32
+)
+
+// Service handles shelf and tag business logic
+type Service struct {
+	repo *Repository
+}
+
+// NewService creates a new shelf service
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// List returns the user's shelves in display order
+func (s *Service) List(userID string) ([]models.Shelf, error) {
+	shelves, err := s.repo.ListByUser(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shelves: %w", err)
+	}
+	return shelves, nil
+}
+
+// Get returns one of the user's shelves
+func (s *Service) Get(userID, shelfID string) (*models.Shelf, error) {
+	return s.repo.FindByID(userID, shelfID)
+}
+
+// Resolve finds a shelf by ID, falling back to its name
+func (s *Service) Resolve(userID, idOrName string) (*models.Shelf, error) {
+	if shelf, err := s.repo.FindByID(userID, idOrName); err == nil {
+		return shelf, nil
+	}
+	return s.repo.FindByName(userID, idOrName)
+}
+
+// Create creates a new shelf at the requested position (or last)
+func (s *Service) Create(userID string, req models.ShelfCreateRequest) (*models.Shelf, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("invalid shelf name: cannot be empty")
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = models.ShelfVisibilityPrivate
+	}
+
+	shelf := &models.Shelf{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		Name:       name,
+		Visibility: visibility,
+	}
+
+	if req.Position != nil {
+		shelf.Position = *req.Position
+	} else {
+		next, err := s.repo.NextPosition(userID)
+		if err != nil {
+			return nil, err
+		}
+		shelf.Position = next
+	}
+
+	if err := s.repo.Create(shelf); err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("shelf name already exists")
+		}
+		return nil, err
+	}
+
+	return s.repo.FindByID(userID, shelf.ID)
+}
+
+// Update renames, moves or changes the visibility of a shelf
+func (s *Service) Update(userID, shelfID string, req models.ShelfUpdateRequest) (*models.Shelf, error) {
+	shelf, err := s.repo.FindByID(userID, shelfID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("invalid shelf name: cannot be empty")
+		}
+		shelf.Name = name
+	}
+	if req.Visibility != nil {
+		shelf.Visibility = *req.Visibility
+	}
+	if req.Position != nil {
+		shelf.Position = *req.Position
+	}
+
+	if err := s.repo.Update(shelf); err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("shelf name already exists")
+		}
+		return nil, err
+	}
+
+	return s.repo.FindByID(userID, shelfID)
+}
+
+// Delete removes a shelf without touching the library entries on it
+func (s *Service) Delete(userID, shelfID string) error {
+	return s.repo.Delete(userID, shelfID)
+}
+
+// Reorder sets the display order of the user's shelves
+func (s *Service) Reorder(userID string, req models.ShelfOrderRequest) ([]models.Shelf, error) {
+	seen := make(map[string]bool, len(req.ShelfIDs))
+	for _, id := range req.ShelfIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("invalid shelf order: duplicate shelf %s", id)
+		}
+		seen[id] = true
+	}
+
+	if err := s.repo.Reorder(userID, req.ShelfIDs); err != nil {
+		return nil, err
+	}
+
+	return s.List(userID)
+}
+
+// AddEntry puts a manga from the user's library on a shelf
+func (s *Service) AddEntry(userID, shelfID, mangaID string) error {
+	if _, err := s.repo.FindByID(userID, shelfID); err != nil {
+		return err
+	}
+
+	if err := s.requireEntry(userID, mangaID); err != nil {
+		return err
+	}
+
+	return s.repo.AddEntry(shelfID, userID, mangaID)
+}
+
+// RemoveEntry takes a manga off a shelf
+func (s *Service) RemoveEntry(userID, shelfID, mangaID string) error {
+	if _, err := s.repo.FindByID(userID, shelfID); err != nil {
+		return err
+	}
+
+	return s.repo.RemoveEntry(shelfID, mangaID)
+}
+
+// SetTags replaces the tags on a library entry and returns the stored tags
+func (s *Service) SetTags(userID, mangaID string, req models.TagsUpdateRequest) ([]string, error) {
+	tags, err := NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireEntry(userID, mangaID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SetTags(userID, mangaID, tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ListTags returns the user's tags with usage counts
+func (s *Service) ListTags(userID string) ([]models.TagCount, error) {
+	return s.repo.ListTags(userID)
+}
+
+func (s *Service) requireEntry(userID, mangaID string) error {
+	exists, err := s.repo.EntryExists(userID, mangaID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("manga not in user's library")
+	}
+	return nil
+}
+
+// NormalizeTags trims, lowercases and de-duplicates tags, rejecting ones that are too long
+func NormalizeTags(raw []string) ([]string, error) {
+	tags := []string{}
+	seen := make(map[string]bool)
+
+	for _, t := range raw {
+		tag := strings.ToLower(strings.Join(strings.Fields(t), " "))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("invalid tag: %q is longer than %d characters", tag, maxTagLength)
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	if len(tags) > maxTagsPerEntry {
+		return nil, fmt.Errorf("invalid tag: at most %d tags per entry", maxTagsPerEntry)
+	}
+
+	return tags, nil
+}
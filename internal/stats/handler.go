@@ -2,6 +2,7 @@ package stats
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/tnphucccc/mangahub/pkg/models"
@@ -41,3 +42,53 @@ func (h *Handler) GetStats(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, stats)
 }
+
+// GetTimeline returns the chapters the authenticated user read over time, with
+// their reading streaks
+// GET /users/stats/timeline
+func (h *Handler) GetTimeline(c *gin.Context) {
+	u, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+	user := u.(*models.User)
+
+	var query models.TimelineQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "Invalid query parameters: bucket must be day, week or month")
+		return
+	}
+
+	timeline, err := h.service.GetTimeline(user.ID, query)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid ") {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalError(c, "Failed to get reading timeline")
+		return
+	}
+
+	response.Success(c, http.StatusOK, timeline)
+}
+
+// GetComposition breaks the authenticated user's library down by genre, author,
+// publication status and rating
+// GET /users/stats/composition
+func (h *Handler) GetComposition(c *gin.Context) {
+	u, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+	user := u.(*models.User)
+
+	composition, err := h.service.GetComposition(user.ID, true)
+	if err != nil {
+		response.InternalError(c, "Failed to get library composition")
+		return
+	}
+
+	response.Success(c, http.StatusOK, composition)
+}
@@ -3,6 +3,8 @@ package stats
 import (
 	"database/sql"
 	"fmt"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
 )
 
 // Repository handles statistics data access
@@ -23,33 +25,49 @@ type UserStats struct {
 	ReadingManga      int     `json:"reading_manga"`
 	PlanToReadManga   int     `json:"plan_to_read_manga"`
 	AverageRating     float64 `json:"average_rating"`
+	Rereads           int     `json:"rereads"`
+	RereadChapters    int     `json:"reread_chapters"`
 }
 
-// GetUserStats calculates statistics for a specific user
+// GetUserStats calculates statistics for a specific user.
+// Chapters are counted once per series in total_chapters_read; chapters read
+// again on later passes are counted in reread_chapters instead.
 func (r *Repository) GetUserStats(userID string) (*UserStats, error) {
 	stats := &UserStats{}
 
-	// 1. Count by status
+	// 1. Count by status, joining each entry's archived passes
 	query := `
 		SELECT 
 			COUNT(*) as total,
-			SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END) as completed,
-			SUM(CASE WHEN status = 'reading' THEN 1 ELSE 0 END) as reading,
-			SUM(CASE WHEN status = 'plan_to_read' THEN 1 ELSE 0 END) as plan_to_read,
-			COALESCE(SUM(current_chapter), 0) as total_chapters,
-			AVG(CASE WHEN rating > 0 THEN rating ELSE NULL END) as avg_rating
-		FROM user_progress
-		WHERE user_id = ?
+			SUM(CASE WHEN up.status = 'completed' THEN 1 ELSE 0 END) as completed,
+			SUM(CASE WHEN up.status = 'reading' THEN 1 ELSE 0 END) as reading,
+			SUM(CASE WHEN up.status = 'plan_to_read' THEN 1 ELSE 0 END) as plan_to_read,
+			COALESCE(SUM(MAX(CAST(up.current_chapter AS INTEGER), COALESCE(rt.max_chapter, 0))), 0) as total_chapters,
+			AVG(CASE WHEN up.rating > 0 THEN up.rating ELSE NULL END) as avg_rating,
+			COALESCE(SUM(up.reread_count), 0) as rereads,
+			COALESCE(SUM(rt.sum_chapter - rt.max_chapter + MIN(CAST(up.current_chapter AS INTEGER), rt.max_chapter)), 0) as reread_chapters
+		FROM user_progress up
+		LEFT JOIN (
+			SELECT manga_id,
+				SUM(CAST(chapter AS INTEGER)) as sum_chapter,
+				MAX(CAST(chapter AS INTEGER)) as max_chapter
+			FROM read_throughs
+			WHERE user_id = ?
+			GROUP BY manga_id
+		) rt ON rt.manga_id = up.manga_id
+		WHERE up.user_id = ?
 	`
 
 	var avgRating sql.NullFloat64
-	err := r.db.QueryRow(query, userID).Scan(
+	err := r.db.QueryRow(query, userID, userID).Scan(
 		&stats.TotalManga,
 		&stats.CompletedManga,
 		&stats.ReadingManga,
 		&stats.PlanToReadManga,
 		&stats.TotalChaptersRead,
 		&avgRating,
+		&stats.Rereads,
+		&stats.RereadChapters,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user stats: %w", err)
@@ -61,3 +79,106 @@ func (r *Repository) GetUserStats(userID string) (*UserStats, error) {
 
 	return stats, nil
 }
+
+// RecordChapters adds chapters to what the user read on day (YYYY-MM-DD, UTC)
+func (r *Repository) RecordChapters(userID, day string, chapters int) error {
+	_, err := r.db.Exec(`
+		INSERT INTO reading_days (user_id, day, chapters) VALUES (?, ?, ?)
+		ON CONFLICT(user_id, day) DO UPDATE SET chapters = chapters + excluded.chapters
+	`, userID, day, chapters)
+	if err != nil {
+		return fmt.Errorf("failed to record reading day: %w", err)
+	}
+	return nil
+}
+
+// DailyChapters returns the chapters the user read on each day from from to to
+// (both inclusive, YYYY-MM-DD). Days without reading are left out.
+func (r *Repository) DailyChapters(userID, from, to string) (map[string]int, error) {
+	rows, err := r.db.Query(`
+		SELECT day, chapters FROM reading_days
+		WHERE user_id = ? AND day >= ? AND day <= ? AND chapters > 0
+	`, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reading days: %w", err)
+	}
+	defer rows.Close()
+
+	daily := map[string]int{}
+	for rows.Next() {
+		var day string
+		var chapters int
+		if err := rows.Scan(&day, &chapters); err != nil {
+			return nil, fmt.Errorf("failed to scan reading day: %w", err)
+		}
+		daily[day] = chapters
+	}
+
+	return daily, rows.Err()
+}
+
+// ReadingDays returns every day the user read on, oldest first
+func (r *Repository) ReadingDays(userID string) ([]string, error) {
+	rows, err := r.db.Query(`
+		SELECT day FROM reading_days WHERE user_id = ? AND chapters > 0 ORDER BY day
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reading days: %w", err)
+	}
+	defer rows.Close()
+
+	days := []string{}
+	for rows.Next() {
+		var day string
+		if err := rows.Scan(&day); err != nil {
+			return nil, fmt.Errorf("failed to scan reading day: %w", err)
+		}
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
+
+// LibraryEntries returns the user's library entries with the genres, author and
+// publication status of each manga. Hidden entries are left out unless includeHidden is set.
+func (r *Repository) LibraryEntries(userID string, includeHidden bool) ([]models.UserProgressWithManga, error) {
+	rows, err := r.db.Query(`
+		SELECT up.manga_id, up.status, up.current_chapter, up.rating, m.title, m.author, m.genres, m.status
+		FROM user_progress up
+		JOIN manga m ON m.id = up.manga_id
+		WHERE up.user_id = ? AND (? OR up.hidden = 0)
+	`, userID, includeHidden)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list library entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []models.UserProgressWithManga{}
+	for rows.Next() {
+		var entry models.UserProgressWithManga
+		var author sql.NullString
+		var genresJSON string
+		err := rows.Scan(
+			&entry.MangaID,
+			&entry.Status,
+			&entry.CurrentChapter,
+			&entry.Rating,
+			&entry.Manga.Title,
+			&author,
+			&genresJSON,
+			&entry.Manga.Status,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan library entry: %w", err)
+		}
+		if err := entry.Manga.UnmarshalGenres(genresJSON); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
+		}
+		entry.UserID = userID
+		entry.Manga.ID = entry.MangaID
+		entry.Manga.Author = author.String
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
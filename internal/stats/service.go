@@ -1,5 +1,21 @@
 package stats
 
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// maxTimelinePoints caps how many buckets a timeline may span
+const maxTimelinePoints = 366
+
+// defaultTimelinePoints is how many buckets a timeline spans when from is not given
+const defaultTimelinePoints = 30
+
 // Service handles statistics business logic
 type Service struct {
 	repo *Repository
@@ -14,3 +30,269 @@ func NewService(repo *Repository) *Service {
 func (s *Service) GetUserStats(userID string) (*UserStats, error) {
 	return s.repo.GetUserStats(userID)
 }
+
+// GetComposition breaks the user's library down by genre, author, publication
+// status and rating. Hidden entries only count when includeHidden is set, as
+// for the owner.
+func (s *Service) GetComposition(userID string, includeHidden bool) (*models.LibraryComposition, error) {
+	entries, err := s.repo.LibraryEntries(userID, includeHidden)
+	if err != nil {
+		return nil, err
+	}
+	return BuildComposition(entries), nil
+}
+
+// RecordProgressChange adds the whole chapters a progress update moved forward
+// to what the user read today. Going back records nothing.
+func (s *Service) RecordProgressChange(before, after models.UserProgress) error {
+	chapters := int(after.CurrentChapter) - int(before.CurrentChapter)
+	if chapters <= 0 {
+		return nil
+	}
+	return s.repo.RecordChapters(after.UserID, time.Now().UTC().Format(models.DateLayout), chapters)
+}
+
+// GetTimeline returns the chapters the user read in each bucket of a date
+// range, with their reading streaks
+func (s *Service) GetTimeline(userID string, query models.TimelineQuery) (*models.ReadingTimeline, error) {
+	return s.timeline(userID, query, time.Now())
+}
+
+func (s *Service) timeline(userID string, query models.TimelineQuery, now time.Time) (*models.ReadingTimeline, error) {
+	bucket := query.Bucket
+	if bucket == "" {
+		bucket = models.TimelineBucketDay
+	}
+
+	to, _ := models.TimelineBucketDay.Window(now)
+	if query.To != "" {
+		day, err := time.Parse(models.DateLayout, query.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: use YYYY-MM-DD")
+		}
+		to = day
+	}
+
+	// A range starts at the beginning of the bucket holding from, and by
+	// default spans the last defaultTimelinePoints buckets up to to
+	from, _ := bucket.Window(to)
+	for i := 1; i < defaultTimelinePoints; i++ {
+		from, _ = bucket.Window(from.Add(-time.Hour))
+	}
+	if query.From != "" {
+		day, err := time.Parse(models.DateLayout, query.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: use YYYY-MM-DD")
+		}
+		from, _ = bucket.Window(day)
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("invalid range: from is after to")
+	}
+
+	daily, err := s.repo.DailyChapters(userID, from.Format(models.DateLayout), to.Format(models.DateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := BucketChapters(daily, bucket, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.repo.ReadingDays(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &models.ReadingTimeline{
+		Bucket:     bucket,
+		From:       from.Format(models.DateLayout),
+		To:         to.Format(models.DateLayout),
+		Points:     points,
+		ActiveDays: len(daily),
+		Streak:     ComputeStreak(days, now),
+	}
+	for _, chapters := range daily {
+		timeline.TotalChapters += chapters
+	}
+
+	return timeline, nil
+}
+
+// BucketChapters sums daily chapter counts into one point per bucket from the
+// bucket holding from to the one holding to, including empty buckets
+func BucketChapters(daily map[string]int, bucket models.TimelineBucket, from, to time.Time) ([]models.TimelinePoint, error) {
+	points := []models.TimelinePoint{}
+	index := map[string]int{}
+	for start, _ := bucket.Window(from); !start.After(to); _, start = bucket.Window(start) {
+		if len(points) == maxTimelinePoints {
+			return nil, fmt.Errorf("invalid range: a timeline has at most %d points", maxTimelinePoints)
+		}
+		index[start.Format(models.DateLayout)] = len(points)
+		points = append(points, models.TimelinePoint{Start: start.Format(models.DateLayout)})
+	}
+
+	for day, chapters := range daily {
+		t, err := time.Parse(models.DateLayout, day)
+		if err != nil {
+			continue
+		}
+		start, _ := bucket.Window(t)
+		if i, ok := index[start.Format(models.DateLayout)]; ok {
+			points[i].Chapters += chapters
+		}
+	}
+
+	return points, nil
+}
+
+// ComputeStreak finds the current and longest runs of consecutive days in
+// days (YYYY-MM-DD, oldest first). The current run counts only when it reaches
+// today or yesterday, so a streak is not lost before the day is over.
+func ComputeStreak(days []string, now time.Time) models.ReadingStreak {
+	streak := models.ReadingStreak{}
+
+	var run int
+	var previous time.Time
+	for _, day := range days {
+		t, err := time.Parse(models.DateLayout, day)
+		if err != nil {
+			continue
+		}
+		if run > 0 && t.Equal(previous.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		previous = t
+		streak.Longest = max(streak.Longest, run)
+	}
+
+	if run > 0 {
+		last := previous.Format(models.DateLayout)
+		streak.LastReadOn = &last
+
+		today, _ := models.TimelineBucketDay.Window(now)
+		if !previous.Before(today.AddDate(0, 0, -1)) {
+			streak.Current = run
+		}
+	}
+
+	return streak
+}
+
+// compositionGroups collects groups by a case-insensitive key, naming each
+// group after the first spelling seen
+type compositionGroups struct {
+	groups  map[string]*models.CompositionGroup
+	ratings map[string]int
+}
+
+func newCompositionGroups() *compositionGroups {
+	return &compositionGroups{groups: map[string]*models.CompositionGroup{}, ratings: map[string]int{}}
+}
+
+// add counts an entry toward the group called name
+func (g *compositionGroups) add(name string, entry models.UserProgressWithManga) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+	key := strings.ToLower(name)
+
+	group, ok := g.groups[key]
+	if !ok {
+		group = &models.CompositionGroup{Name: name}
+		g.groups[key] = group
+	}
+
+	group.Titles++
+	group.ChaptersRead += entry.CurrentChapter.Whole()
+	switch entry.Status {
+	case models.ReadingStatusPlanToRead:
+		// Not started
+	case models.ReadingStatusCompleted:
+		group.Started++
+		group.Completed++
+	case models.ReadingStatusDropped:
+		group.Started++
+		group.Dropped++
+	default:
+		group.Started++
+	}
+	if entry.Rating != nil {
+		group.Rated++
+		g.ratings[key] += *entry.Rating
+	}
+}
+
+// list returns the groups with their averages and rates, most titles first
+func (g *compositionGroups) list() []models.CompositionGroup {
+	list := make([]models.CompositionGroup, 0, len(g.groups))
+	for key, group := range g.groups {
+		if group.Rated > 0 {
+			group.AverageRating = ratio(g.ratings[key], group.Rated)
+		}
+		if group.Started > 0 {
+			group.CompletionRate = ratio(group.Completed, group.Started)
+			group.DroppedRate = ratio(group.Dropped, group.Started)
+		}
+		list = append(list, *group)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Titles != list[j].Titles {
+			return list[i].Titles > list[j].Titles
+		}
+		return strings.ToLower(list[i].Name) < strings.ToLower(list[j].Name)
+	})
+	return list
+}
+
+// ratio returns n/d rounded to two decimals
+func ratio(n, d int) *float64 {
+	r := math.Round(float64(n)/float64(d)*100) / 100
+	return &r
+}
+
+// BuildComposition breaks library entries down by genre, author, publication
+// status and rating. An entry counts toward each of its genres.
+func BuildComposition(entries []models.UserProgressWithManga) *models.LibraryComposition {
+	genres := newCompositionGroups()
+	authors := newCompositionGroups()
+	statuses := newCompositionGroups()
+	histogram := models.RatingHistogram{Counts: make([]int, 10)}
+
+	ratingSum := 0
+	for _, entry := range entries {
+		seen := map[string]bool{}
+		for _, genre := range entry.Manga.Genres {
+			key := strings.ToLower(strings.TrimSpace(genre))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			genres.add(genre, entry)
+		}
+		authors.add(entry.Manga.Author, entry)
+		statuses.add(string(entry.Manga.Status), entry)
+
+		if entry.Rating != nil && *entry.Rating >= 1 && *entry.Rating <= 10 {
+			histogram.Counts[*entry.Rating-1]++
+			histogram.Rated++
+			ratingSum += *entry.Rating
+		}
+	}
+	if histogram.Rated > 0 {
+		histogram.AverageRating = ratio(ratingSum, histogram.Rated)
+	}
+
+	return &models.LibraryComposition{
+		Titles:              len(entries),
+		Genres:              genres.list(),
+		Authors:             authors.list(),
+		PublicationStatuses: statuses.list(),
+		Ratings:             histogram,
+	}
+}
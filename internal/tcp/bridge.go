@@ -0,0 +1,75 @@
+package tcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/auth"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// NotifyProgress relays a stored progress update to the TCP server at address
+// so it reaches the user's audience. The connection authenticates with a
+// service token, which is what allows it to send progress for another user.
+func NotifyProgress(address string, jwtManager *auth.JWTManager, progress models.TCPProgressBroadcast) error {
+	token, err := jwtManager.GenerateServiceToken("progress-bridge")
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("tcp", address, 2*time.Second)
+	if err != nil {
+		return fmt.Errorf("failed to connect to TCP server: %w", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	writer := bufio.NewWriter(conn)
+	reader := bufio.NewReader(conn)
+
+	if err := writeMessage(writer, models.TCPMessage{
+		Type:      models.TCPMessageTypeAuth,
+		Timestamp: time.Now(),
+		Data:      models.TCPAuthMessage{Token: token},
+	}); err != nil {
+		return err
+	}
+
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read auth response: %w", err)
+	}
+	var reply models.TCPMessage
+	if err := json.Unmarshal(line, &reply); err != nil || reply.Type != models.TCPMessageTypeAuthSuccess {
+		return fmt.Errorf("TCP server rejected the service token: %s", line)
+	}
+
+	return writeMessage(writer, models.TCPMessage{
+		Type:      models.TCPMessageTypeProgress,
+		Timestamp: time.Now(),
+		Data: models.TCPProgressMessage{
+			UserID:         progress.UserID,
+			MangaID:        progress.MangaID,
+			MangaTitle:     progress.MangaTitle,
+			Username:       progress.Username,
+			CurrentChapter: progress.CurrentChapter,
+			Status:         progress.Status,
+			Version:        progress.Version,
+		},
+	})
+}
+
+// writeMessage writes msg as one JSON line
+func writeMessage(writer *bufio.Writer, msg models.TCPMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+	if _, err := writer.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return writer.Flush()
+}
@@ -18,21 +18,26 @@ type Client struct {
 	ID       string
 	UserID   string
 	Username string
+	Service  bool // Another MangaHub server relaying progress on behalf of users
 	Conn     net.Conn
 	Writer   *bufio.Writer
 	mu       sync.Mutex
 }
 
+// AudienceResolver decides which users may receive a progress broadcast
+type AudienceResolver func(broadcast models.TCPProgressBroadcast) (models.BroadcastAudience, error)
+
 // Server represents the TCP progress sync server
 type Server struct {
-	Port       string
-	listener   net.Listener
-	clients    map[string]*Client   // clientID -> Client
-	userIndex  map[string][]*Client // userID -> []*Client (multiple devices per user)
-	mu         sync.RWMutex
-	broadcast  chan models.TCPProgressBroadcast
-	jwtManager *auth.JWTManager
-	shutdown   chan struct{}
+	Port            string
+	listener        net.Listener
+	clients         map[string]*Client   // clientID -> Client
+	userIndex       map[string][]*Client // userID -> []*Client (multiple devices per user)
+	mu              sync.RWMutex
+	broadcast       chan models.TCPProgressBroadcast
+	jwtManager      *auth.JWTManager
+	resolveAudience AudienceResolver
+	shutdown        chan struct{}
 }
 
 // NewServer creates a new TCP server instance
@@ -47,6 +52,12 @@ func NewServer(port string, jwtManager *auth.JWTManager) *Server {
 	}
 }
 
+// UseAudienceResolver sets how the audience of each broadcast is decided.
+// Without one, broadcasts only reach the devices of the user they are about.
+func (s *Server) UseAudienceResolver(fn AudienceResolver) {
+	s.resolveAudience = fn
+}
+
 // Start starts the TCP server
 func (s *Server) Start() error {
 	addr := fmt.Sprintf(":%s", s.Port)
@@ -147,11 +158,14 @@ func (s *Server) handleConnection(conn net.Conn) {
 			// Authentication successful
 			client.UserID = claims.UserID
 			client.Username = claims.Username
+			client.Service = claims.Service
 			isAuthenticated = true
 
-			// Register client
-			s.registerClient(client)
-			defer s.unregisterClient(client)
+			// Register client; bridges only send, so they do not receive broadcasts
+			if !client.Service {
+				s.registerClient(client)
+				defer s.unregisterClient(client)
+			}
 
 			// Send auth success
 			s.sendAuthSuccess(client)
@@ -159,13 +173,16 @@ func (s *Server) handleConnection(conn net.Conn) {
 			// Remove read deadline
 			conn.SetReadDeadline(time.Time{})
 
-			log.Printf("Client %s authenticated as user %s (%s)", clientID, claims.Username, claims.UserID)
+			if client.Service {
+				log.Printf("Client %s authenticated as service %s", clientID, claims.Username)
+			} else {
+				log.Printf("Client %s authenticated as user %s (%s)", clientID, claims.Username, claims.UserID)
+			}
 			continue
 		}
 
-		// For other messages, check if it's a progress update (allowed without auth for internal bridge)
-		// OR if the client is already authenticated.
-		if msg.Type == models.TCPMessageTypeProgress || isAuthenticated {
+		// Every other message, progress updates included, requires authentication
+		if isAuthenticated {
 			s.handleMessage(client, msg)
 		} else {
 			s.sendAuthFailed(client, "Authentication required")
@@ -213,18 +230,18 @@ func (s *Server) handleProgressUpdate(client *Client, msg models.TCPMessage) {
 		return
 	}
 
+	// Users always broadcast as themselves; only a service bridge may name the user
 	username := client.Username
 	userID := client.UserID
 	mangaTitle := progressData.MangaTitle
 
-	// If unauthenticated (internal bridge), use data from message
-	if username == "" {
-		if progressData.Username != "" {
-			username = progressData.Username
-		} else {
-			username = "System"
+	if client.Service {
+		if progressData.UserID == "" {
+			s.sendError(client, "INVALID_DATA", "user_id is required")
+			return
 		}
-		userID = "system"
+		userID = progressData.UserID
+		username = progressData.Username
 	}
 
 	// Create broadcast message
@@ -235,35 +252,42 @@ func (s *Server) handleProgressUpdate(client *Client, msg models.TCPMessage) {
 		MangaTitle:     mangaTitle,
 		CurrentChapter: progressData.CurrentChapter,
 		Status:         progressData.Status,
+		Version:        progressData.Version,
 		Timestamp:      time.Now(),
 	}
 
 	// Send to broadcast channel
 	s.broadcast <- broadcast
 
-	log.Printf("Progress update (broadcasted): manga=%s, chapter=%d", progressData.MangaID, progressData.CurrentChapter)
+	log.Printf("Progress update (broadcasted): manga=%s, chapter=%s", progressData.MangaID, progressData.CurrentChapter)
 }
 
-// broadcastLoop listens for broadcast messages and sends to all clients
+// broadcastLoop listens for broadcast messages and delivers them to their audience
 func (s *Server) broadcastLoop() {
 	for {
 		select {
 		case broadcast := <-s.broadcast:
-			s.broadcastToAll(broadcast)
+			s.deliver(broadcast)
 		case <-s.shutdown:
 			return
 		}
 	}
 }
 
-// broadcastToAll sends a message to all connected clients
-func (s *Server) broadcastToAll(broadcast models.TCPProgressBroadcast) {
-	s.mu.RLock()
-	clients := make([]*Client, 0, len(s.clients))
-	for _, client := range s.clients {
-		clients = append(clients, client)
+// deliver sends a broadcast to the clients in its audience. When the audience
+// cannot be resolved, only the user's own devices receive it.
+func (s *Server) deliver(broadcast models.TCPProgressBroadcast) {
+	audience := models.BroadcastAudience{UserIDs: []string{broadcast.UserID}}
+	if s.resolveAudience != nil {
+		resolved, err := s.resolveAudience(broadcast)
+		if err != nil {
+			log.Printf("Failed to resolve audience for user %s: %v", broadcast.UserID, err)
+		} else {
+			audience = resolved
+		}
 	}
-	s.mu.RUnlock()
+
+	clients := s.audienceClients(audience)
 
 	msg := models.TCPMessage{
 		Type:      models.TCPMessageTypeBroadcast,
@@ -271,7 +295,7 @@ func (s *Server) broadcastToAll(broadcast models.TCPProgressBroadcast) {
 		Data:      broadcast,
 	}
 
-	// Send to all clients in parallel (non-blocking)
+	// Send to clients in parallel (non-blocking)
 	for _, client := range clients {
 		go s.sendMessage(client, msg)
 	}
@@ -279,7 +303,32 @@ func (s *Server) broadcastToAll(broadcast models.TCPProgressBroadcast) {
 	log.Printf("Broadcasted progress update to %d clients", len(clients))
 }
 
-// BroadcastProgress sends a progress update to all connected clients (called from HTTP API)
+// audienceClients returns the connected clients in an audience
+func (s *Server) audienceClients(audience models.BroadcastAudience) []*Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if audience.Public {
+		clients := make([]*Client, 0, len(s.clients))
+		for _, client := range s.clients {
+			clients = append(clients, client)
+		}
+		return clients
+	}
+
+	var clients []*Client
+	seen := make(map[string]bool, len(audience.UserIDs))
+	for _, userID := range audience.UserIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		clients = append(clients, s.userIndex[userID]...)
+	}
+	return clients
+}
+
+// BroadcastProgress queues a progress update for the clients in its audience (called from HTTP API)
 func (s *Server) BroadcastProgress(broadcast models.TCPProgressBroadcast) {
 	select {
 	case s.broadcast <- broadcast:
@@ -0,0 +1,39 @@
+package udp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// Notify hands a notification to the UDP server at address, which sends it on
+// to the registered clients it is meant for
+func Notify(address string, notification models.UDPNotification) error {
+	addr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return fmt.Errorf("failed to resolve UDP address: %w", err)
+	}
+
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return fmt.Errorf("failed to dial UDP: %w", err)
+	}
+	defer conn.Close()
+
+	data, err := json.Marshal(models.UDPMessage{
+		Type:      models.UDPMessageTypeNotification,
+		Timestamp: time.Now(),
+		Data:      notification,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	if _, err := conn.Write(data); err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	return nil
+}
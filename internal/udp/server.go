@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/tnphucccc/mangahub/internal/auth"
 	"github.com/tnphucccc/mangahub/pkg/models"
 )
 
@@ -29,12 +30,14 @@ type Server struct {
 	notify     chan models.UDPNotification
 	shutdown   chan struct{}
 	bufferSize int // Size of UDP receive buffer
+	jwtManager *auth.JWTManager
 }
 
 // NewServer creates a new UDP notification server instance
-func NewServer(port string) *Server {
+func NewServer(port string, jwtManager *auth.JWTManager) *Server {
 	return &Server{
 		Port:       port,
+		jwtManager: jwtManager,
 		clients:    make(map[string]*RegisteredClient),
 		notify:     make(chan models.UDPNotification, 100),
 		shutdown:   make(chan struct{}),
@@ -162,11 +165,24 @@ func (s *Server) handleRegister(addr *net.UDPAddr, msg models.UDPMessage) {
 		return
 	}
 
-	// Register client
+	// Validate JWT token; personal notifications are routed by the user it names,
+	// never by the user_id a client claims
+	if registerData.Token == "" {
+		s.sendRegisterFailed(addr, "Authentication token is required")
+		return
+	}
+	claims, err := s.jwtManager.ValidateToken(registerData.Token)
+	if err != nil {
+		s.sendRegisterFailed(addr, "Invalid or expired token")
+		return
+	}
+
+	// Register client. Services (the API server relaying chapter releases) have
+	// no user, so they receive broadcasts only.
 	client := &RegisteredClient{
 		ClientID: registerData.ClientID,
-		UserID:   registerData.UserID,
-		Username: registerData.Username,
+		UserID:   claims.UserID,
+		Username: claims.Username,
 		Address:  addr,
 		LastSeen: time.Now(),
 	}
@@ -176,7 +192,7 @@ func (s *Server) handleRegister(addr *net.UDPAddr, msg models.UDPMessage) {
 	// Send success response
 	s.sendRegisterSuccess(addr, registerData.ClientID)
 
-	log.Printf("Client registered: %s (user: %s) from %s", registerData.ClientID, registerData.Username, addr.String())
+	log.Printf("Client registered: %s (user: %s) from %s", registerData.ClientID, claims.Username, addr.String())
 }
 
 // handleUnregister handles client unregistration
@@ -275,11 +291,15 @@ func (s *Server) cleanupStaleClients() {
 	}
 }
 
-// broadcastNotification sends a notification to all registered clients
+// broadcastNotification sends a notification to all registered clients, or only
+// to the clients of notification.UserID when it is set
 func (s *Server) broadcastNotification(notification models.UDPNotification) {
 	s.mu.RLock()
 	clients := make([]*RegisteredClient, 0, len(s.clients))
 	for _, client := range s.clients {
+		if notification.UserID != "" && client.UserID != notification.UserID {
+			continue
+		}
 		clients = append(clients, client)
 	}
 	s.mu.RUnlock()
@@ -298,7 +318,13 @@ func (s *Server) broadcastNotification(notification models.UDPNotification) {
 		}
 	}
 
-	log.Printf("Broadcasted notification to %d/%d clients: %s - Chapter %d",
+	if notification.UserID != "" {
+		log.Printf("Sent notification to %d/%d clients of user %s: %s",
+			successCount, len(clients), notification.UserID, notification.Message)
+		return
+	}
+
+	log.Printf("Broadcasted notification to %d/%d clients: %s - Chapter %s",
 		successCount, len(clients), notification.MangaTitle, notification.ChapterNumber)
 }
 
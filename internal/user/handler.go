@@ -37,6 +37,10 @@ func (h *Handler) Register(c *gin.Context) {
 			response.Conflict(c, err.Error())
 			return
 		}
+		if err.Error() == "username is reserved" {
+			response.BadRequest(c, "Username is reserved")
+			return
+		}
 
 		response.InternalError(c, "Failed to register user")
 		return
@@ -89,3 +93,29 @@ func (h *Handler) GetProfile(c *gin.Context) {
 		"user": user.ToResponse(),
 	})
 }
+
+// UpdatePrivacy changes who can see the current user's profile, library and activity
+// PUT /users/me/privacy
+func (h *Handler) UpdatePrivacy(c *gin.Context) {
+	userInterface, exists := c.Get("user")
+	if !exists {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	var req models.PrivacyUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	user, err := h.service.UpdatePrivacy(userInterface.(*models.User).ID, req)
+	if err != nil {
+		response.InternalError(c, "Failed to update privacy settings")
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"user": user.ToResponse(),
+	})
+}
@@ -38,6 +38,8 @@ func (r *Repository) scanUser(row *sql.Row) (*models.User, error) {
 		&user.Username,
 		&user.Email,
 		&user.PasswordHash,
+		&user.Visibility,
+		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -53,7 +55,7 @@ func (r *Repository) scanUser(row *sql.Row) (*models.User, error) {
 // findByField is a generic finder that reduces duplication for FindByID, FindByUsername, FindByEmail
 func (r *Repository) findByField(field string, value interface{}) (*models.User, error) {
 	query := fmt.Sprintf(`
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, password_hash, visibility, role, created_at, updated_at
 		FROM users
 		WHERE %s = ?
 	`, field)
@@ -87,3 +89,23 @@ func (r *Repository) Exists(username, email string) (bool, error) {
 	}
 	return count > 0, nil
 }
+
+// UpdateVisibility changes who can see a user's profile, library and activity
+func (r *Repository) UpdateVisibility(userID string, visibility models.ProfileVisibility) error {
+	result, err := r.db.Exec(`
+		UPDATE users SET visibility = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+	`, visibility, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update visibility: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
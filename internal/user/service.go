@@ -24,6 +24,11 @@ func NewService(repo *Repository, jwtManager *auth.JWTManager) *Service {
 
 // Register registers a new user
 func (s *Service) Register(req models.UserRegisterRequest) (*models.User, string, error) {
+	// Public profiles live at /users/:username next to the /users routes
+	if models.IsReservedUsername(req.Username) {
+		return nil, "", fmt.Errorf("username is reserved")
+	}
+
 	// Check if user already exists
 	exists, err := s.repo.Exists(req.Username, req.Email)
 	if err != nil {
@@ -45,6 +50,8 @@ func (s *Service) Register(req models.UserRegisterRequest) (*models.User, string
 		Username:     req.Username,
 		Email:        req.Email,
 		PasswordHash: hashedPassword,
+		Visibility:   models.ProfileVisibilityPublic,
+		Role:         models.UserRoleUser,
 	}
 
 	// Save to database
@@ -92,6 +99,23 @@ func (s *Service) GetByID(id string) (*models.User, error) {
 	return user, nil
 }
 
+// GetByUsername retrieves a user by username
+func (s *Service) GetByUsername(username string) (*models.User, error) {
+	user, err := s.repo.FindByUsername(username)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
+
+// UpdatePrivacy changes who can see the user's profile, library and activity
+func (s *Service) UpdatePrivacy(userID string, req models.PrivacyUpdateRequest) (*models.User, error) {
+	if err := s.repo.UpdateVisibility(userID, req.Visibility); err != nil {
+		return nil, err
+	}
+	return s.GetByID(userID)
+}
+
 // ValidateToken validates a JWT token and returns the user
 func (s *Service) ValidateToken(tokenString string) (*models.User, error) {
 	// Validate token
@@ -99,6 +123,9 @@ func (s *Service) ValidateToken(tokenString string) (*models.User, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
+	if claims.Service {
+		return nil, fmt.Errorf("invalid token: not a user token")
+	}
 
 	// Get user from database
 	user, err := s.repo.FindByID(claims.UserID)
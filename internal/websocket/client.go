@@ -52,6 +52,9 @@ type Client struct {
 	// Username of the client
 	username string
 
+	// ID of the signed-in user; empty for anonymous clients
+	userID string
+
 	// Room the client is in
 	room string
 }
@@ -69,6 +72,10 @@ func (c *Client) readPump() {
 	c.conn.SetReadLimit(maxMessageSize)
 	c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.conn.SetPongHandler(func(string) error { c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
+
+	// The room and name this client sends with; the hub owns c.room and
+	// c.username once the client is registered
+	room, username := c.room, c.username
 	for {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
@@ -89,13 +96,19 @@ func (c *Client) readPump() {
 		// Handle different message types
 		switch wsMsg.Type {
 		case models.WSJoinRoom:
-			// Update client's room
-			c.room = wsMsg.Room
-			c.username = wsMsg.Username
+			// The hub moves the client; private rooms need membership
+			allowed := c.hub.CanJoin(wsMsg.Room, c.userID)
+			c.hub.join <- roomJoin{client: c, room: wsMsg.Room, username: wsMsg.Username, allowed: allowed}
+			if allowed {
+				room = wsMsg.Room
+				if c.userID == "" && wsMsg.Username != "" {
+					username = wsMsg.Username
+				}
+			}
 		case models.WSChatMessage:
 			// Broadcast chat message to room
-			wsMsg.Username = c.username
-			wsMsg.Room = c.room
+			wsMsg.Username = username
+			wsMsg.Room = room
 			wsMsg.Timestamp = time.Now()
 			c.hub.Broadcast <- wsMsg
 		}
@@ -161,6 +174,16 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request) {
 	username := query.Get("username")
 	room := query.Get("room")
 
+	// A valid token identifies the user and replaces the username; clients
+	// without one stay anonymous and can only join public rooms
+	var userID string
+	if token := query.Get("token"); token != "" && hub.authenticate != nil {
+		if user, err := hub.authenticate(token); err == nil {
+			userID = user.ID
+			username = user.Username
+		}
+	}
+
 	// Default to "general" room if not specified
 	if room == "" {
 		room = "general"
@@ -171,11 +194,17 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request) {
 		username = "anonymous"
 	}
 
+	if !hub.CanJoin(room, userID) {
+		rejectConn(conn, "Only members can join this room")
+		return
+	}
+
 	client := &Client{
 		hub:      hub,
 		conn:     conn,
 		send:     make(chan []byte, 256),
 		username: username,
+		userID:   userID,
 		room:     room,
 	}
 	client.hub.register <- client
@@ -185,3 +214,11 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request) {
 	go client.writePump()
 	go client.readPump()
 }
+
+// rejectConn sends an error message to a client that was not admitted and closes the connection
+func rejectConn(conn *websocket.Conn, reason string) {
+	conn.SetWriteDeadline(time.Now().Add(writeWait))
+	conn.WriteJSON(models.NewErrorMessage(reason))
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason))
+	conn.Close()
+}
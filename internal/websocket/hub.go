@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/tnphucccc/mangahub/pkg/models"
 )
@@ -36,6 +37,55 @@ type Hub struct {
 
 	// Unregister requests from clients.
 	unregister chan *Client
+
+	// Requests from clients to move to another room.
+	join chan roomJoin
+
+	// Requests to remove users from rooms.
+	kick chan roomKick
+
+	// System messages about a user's own events.
+	announce chan Announcement
+
+	// Private rooms and who may join them (room -> access check)
+	privateMu sync.RWMutex
+	private   map[string]RoomAccess
+
+	// authenticate identifies clients by the token they connect with
+	authenticate Authenticator
+}
+
+// RoomAccess reports whether the user with the given ID may join a private room
+type RoomAccess func(userID string) bool
+
+// Authenticator returns the user a token belongs to
+type Authenticator func(token string) (*models.User, error)
+
+// roomJoin asks the hub to move a client to another room; allowed is false
+// when the client may not join it. Anonymous clients may pick a new username.
+type roomJoin struct {
+	client   *Client
+	room     string
+	username string
+	allowed  bool
+}
+
+// Announcement is a system message about something a user did. Personal is
+// sent to the user's own clients, whatever room they are in; Public, when set,
+// goes to everyone else in Room.
+type Announcement struct {
+	UserID   string
+	Room     string
+	Public   string
+	Personal string
+}
+
+// roomKick asks the hub to disconnect a user, or everyone when userID is
+// empty, from a room
+type roomKick struct {
+	room   string
+	userID string
+	reason string
 }
 
 func NewHub() *Hub {
@@ -43,10 +93,58 @@ func NewHub() *Hub {
 		Broadcast:  make(chan models.WebSocketMessage),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		join:       make(chan roomJoin),
+		kick:       make(chan roomKick),
+		announce:   make(chan Announcement),
 		rooms:      make(map[string]map[*Client]bool),
+		private:    make(map[string]RoomAccess),
 	}
 }
 
+// UseAuthenticator sets how the tokens clients connect with are checked.
+// Without one, clients are anonymous and cannot join private rooms.
+func (h *Hub) UseAuthenticator(fn Authenticator) {
+	h.authenticate = fn
+}
+
+// CreatePrivateRoom opens a room that only users allowed by access may join
+func (h *Hub) CreatePrivateRoom(room string, access RoomAccess) {
+	h.privateMu.Lock()
+	defer h.privateMu.Unlock()
+	h.private[room] = access
+}
+
+// ClosePrivateRoom disconnects everyone in a private room and removes it
+func (h *Hub) ClosePrivateRoom(room string) {
+	h.privateMu.Lock()
+	delete(h.private, room)
+	h.privateMu.Unlock()
+
+	h.kick <- roomKick{room: room, reason: "This room was closed"}
+}
+
+// RemoveFromRoom disconnects a user's clients from a room
+func (h *Hub) RemoveFromRoom(room, userID string) {
+	h.kick <- roomKick{room: room, userID: userID, reason: "You were removed from this room"}
+}
+
+// Announce delivers an announcement to the connected clients it concerns
+func (h *Hub) Announce(announcement Announcement) {
+	h.announce <- announcement
+}
+
+// CanJoin reports whether the user with the given ID (empty when anonymous) may join a room
+func (h *Hub) CanJoin(room, userID string) bool {
+	h.privateMu.RLock()
+	access, private := h.private[room]
+	h.privateMu.RUnlock()
+
+	if !private {
+		return true
+	}
+	return userID != "" && access(userID)
+}
+
 func (h *Hub) Run(ctx context.Context) {
 	for {
 		select {
@@ -96,6 +194,15 @@ func (h *Hub) Run(ctx context.Context) {
 		case message := <-h.Broadcast:
 			// Broadcast message to all clients in the specified room
 			h.broadcastToRoom(message.Room, message)
+
+		case join := <-h.join:
+			h.moveToRoom(join)
+
+		case kick := <-h.kick:
+			h.kickFromRoom(kick)
+
+		case announcement := <-h.announce:
+			h.deliverAnnouncement(announcement)
 		}
 	}
 }
@@ -123,3 +230,99 @@ func (h *Hub) broadcastToRoom(room string, message models.WebSocketMessage) {
 		}
 	}
 }
+
+// moveToRoom moves a client to the room it asked to join, or tells it why it cannot
+func (h *Hub) moveToRoom(join roomJoin) {
+	client := join.client
+	clients, ok := h.rooms[client.room]
+	if !ok || !clients[client] {
+		return // Already unregistered or removed
+	}
+
+	if !join.allowed {
+		if data, err := json.Marshal(models.NewErrorMessage("Only members can join this room")); err == nil {
+			select {
+			case client.send <- data:
+			default:
+			}
+		}
+		return
+	}
+
+	delete(clients, client)
+	if len(clients) == 0 {
+		delete(h.rooms, client.room)
+	}
+	h.broadcastToRoom(client.room, models.NewSystemMessage(client.room, fmt.Sprintf("%s left the chat", client.username)))
+
+	client.room = join.room
+	if client.userID == "" && join.username != "" {
+		client.username = join.username
+	}
+	if h.rooms[client.room] == nil {
+		h.rooms[client.room] = make(map[*Client]bool)
+	}
+	h.rooms[client.room][client] = true
+	h.broadcastToRoom(client.room, models.NewSystemMessage(client.room, fmt.Sprintf("%s joined the chat", client.username)))
+
+	log.Printf("[Hub] User %s moved to room %s", client.username, client.room)
+}
+
+// kickFromRoom disconnects the clients a kick request applies to
+func (h *Hub) kickFromRoom(kick roomKick) {
+	clients, ok := h.rooms[kick.room]
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(models.NewErrorMessage(kick.reason))
+	if err != nil {
+		log.Printf("[Hub] Error marshaling message: %v", err)
+		return
+	}
+
+	for client := range clients {
+		if kick.userID != "" && client.userID != kick.userID {
+			continue
+		}
+		select {
+		case client.send <- data:
+		default:
+		}
+		delete(clients, client)
+		close(client.send)
+		log.Printf("[Hub] User %s removed from room %s", client.username, kick.room)
+	}
+	if len(clients) == 0 {
+		delete(h.rooms, kick.room)
+	}
+}
+
+// deliverAnnouncement sends the personal message to the user's clients and the
+// public one to the other clients in the announcement's room
+func (h *Hub) deliverAnnouncement(announcement Announcement) {
+	for room, clients := range h.rooms {
+		for client := range clients {
+			var content string
+			switch {
+			case client.userID != "" && client.userID == announcement.UserID:
+				content = announcement.Personal
+			case room == announcement.Room:
+				content = announcement.Public
+			}
+			if content == "" {
+				continue
+			}
+
+			data, err := json.Marshal(models.NewSystemMessage(room, content))
+			if err != nil {
+				log.Printf("[Hub] Error marshaling message: %v", err)
+				return
+			}
+			select {
+			case client.send <- data:
+			default:
+			}
+		}
+	}
+}
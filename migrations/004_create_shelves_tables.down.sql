@@ -0,0 +1,7 @@
+-- Rollback shelves and tags tables
+DROP INDEX IF EXISTS idx_library_tags_tag;
+DROP INDEX IF EXISTS idx_shelf_entries_entry;
+DROP INDEX IF EXISTS idx_shelves_user_id;
+DROP TABLE IF EXISTS library_tags;
+DROP TABLE IF EXISTS shelf_entries;
+DROP TABLE IF EXISTS shelves;
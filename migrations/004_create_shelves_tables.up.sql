@@ -0,0 +1,43 @@
+-- Create user-defined shelves for organizing library entries
+-- Names are unique per user regardless of case, matching how shelves are looked up by name
+CREATE TABLE IF NOT EXISTS shelves (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL COLLATE NOCASE,
+    position INTEGER NOT NULL DEFAULT 0,
+    visibility TEXT NOT NULL DEFAULT 'private' CHECK(visibility IN ('private', 'public')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Many-to-many membership between shelves and library entries
+CREATE TABLE IF NOT EXISTS shelf_entries (
+    shelf_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    added_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (shelf_id, manga_id),
+    FOREIGN KEY (shelf_id) REFERENCES shelves(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id, manga_id) REFERENCES user_progress(user_id, manga_id) ON DELETE CASCADE
+);
+
+-- Free-form tags attached to library entries
+CREATE TABLE IF NOT EXISTS library_tags (
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    tag TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, manga_id, tag),
+    FOREIGN KEY (user_id, manga_id) REFERENCES user_progress(user_id, manga_id) ON DELETE CASCADE
+);
+
+-- Index for listing a user's shelves in order
+CREATE INDEX IF NOT EXISTS idx_shelves_user_id ON shelves(user_id, position);
+
+-- Index for finding the shelves an entry belongs to
+CREATE INDEX IF NOT EXISTS idx_shelf_entries_entry ON shelf_entries(user_id, manga_id);
+
+-- Index for filtering a library by tag
+CREATE INDEX IF NOT EXISTS idx_library_tags_tag ON library_tags(user_id, tag);
@@ -0,0 +1,3 @@
+-- Drop manga aliases table
+DROP INDEX IF EXISTS idx_manga_aliases_alias;
+DROP TABLE IF EXISTS manga_aliases;
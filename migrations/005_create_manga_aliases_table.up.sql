@@ -0,0 +1,11 @@
+-- Alternative titles used to match imported lists against the catalog
+CREATE TABLE IF NOT EXISTS manga_aliases (
+    manga_id TEXT NOT NULL,
+    alias TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (manga_id, alias),
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE
+);
+
+-- Index for looking up manga by alias
+CREATE INDEX IF NOT EXISTS idx_manga_aliases_alias ON manga_aliases(alias);
@@ -0,0 +1,36 @@
+-- Store chapter progress as REAL so extras (10.5) and prologues (0) can be recorded.
+-- SQLite cannot change a column type in place, so the table is rebuilt.
+-- Dropping user_progress cascades to shelf entries and tags, so keep a copy of them.
+CREATE TEMP TABLE shelf_entries_backup AS SELECT * FROM shelf_entries;
+CREATE TEMP TABLE library_tags_backup AS SELECT * FROM library_tags;
+
+CREATE TABLE user_progress_new (
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    current_chapter REAL NOT NULL DEFAULT 0 CHECK(current_chapter >= 0),
+    status TEXT CHECK(status IN ('reading', 'completed', 'plan_to_read', 'on_hold', 'dropped')),
+    rating INTEGER CHECK(rating >= 1 AND rating <= 10),
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, manga_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE
+);
+
+INSERT INTO user_progress_new (user_id, manga_id, current_chapter, status, rating, started_at, completed_at, updated_at)
+SELECT user_id, manga_id, CAST(COALESCE(current_chapter, 0) AS REAL), status, rating, started_at, completed_at, updated_at
+FROM user_progress;
+
+DROP TABLE user_progress;
+ALTER TABLE user_progress_new RENAME TO user_progress;
+
+CREATE INDEX IF NOT EXISTS idx_user_progress_user_id ON user_progress(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_progress_manga_id ON user_progress(manga_id);
+CREATE INDEX IF NOT EXISTS idx_user_progress_status ON user_progress(user_id, status);
+
+INSERT OR IGNORE INTO shelf_entries SELECT * FROM shelf_entries_backup;
+INSERT OR IGNORE INTO library_tags SELECT * FROM library_tags_backup;
+
+DROP TABLE shelf_entries_backup;
+DROP TABLE library_tags_backup;
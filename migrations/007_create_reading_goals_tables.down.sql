@@ -0,0 +1,5 @@
+-- Rollback reading goals tables
+DROP INDEX IF EXISTS idx_reading_goals_user_id;
+DROP INDEX IF EXISTS idx_progress_history_user;
+DROP TABLE IF EXISTS reading_goals;
+DROP TABLE IF EXISTS progress_history;
@@ -0,0 +1,32 @@
+-- Log of chapter progress changes, used to measure reading over time
+CREATE TABLE IF NOT EXISTS progress_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    from_chapter REAL NOT NULL DEFAULT 0,
+    to_chapter REAL NOT NULL DEFAULT 0,
+    status TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE
+);
+
+-- Recurring reading goals
+CREATE TABLE IF NOT EXISTS reading_goals (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    metric TEXT NOT NULL CHECK(metric IN ('chapters', 'completed_series')),
+    genre TEXT NOT NULL DEFAULT '',
+    period TEXT NOT NULL CHECK(period IN ('week', 'month', 'year')),
+    target INTEGER NOT NULL CHECK(target > 0),
+    achieved_period TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index for measuring a user's reading within a period
+CREATE INDEX IF NOT EXISTS idx_progress_history_user ON progress_history(user_id, created_at);
+
+-- Index for listing a user's goals
+CREATE INDEX IF NOT EXISTS idx_reading_goals_user_id ON reading_goals(user_id);
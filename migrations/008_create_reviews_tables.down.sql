@@ -0,0 +1,4 @@
+-- Rollback reviews tables
+DROP INDEX IF EXISTS idx_reviews_manga_id;
+DROP TABLE IF EXISTS review_votes;
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,25 @@
+-- Written reviews attached to library entries
+CREATE TABLE IF NOT EXISTS reviews (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    body TEXT NOT NULL,
+    spoiler INTEGER NOT NULL DEFAULT 0 CHECK(spoiler IN (0, 1)),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, manga_id),
+    FOREIGN KEY (user_id, manga_id) REFERENCES user_progress(user_id, manga_id) ON DELETE CASCADE
+);
+
+-- Helpful votes cast on reviews by other users
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index for listing a manga's reviews
+CREATE INDEX IF NOT EXISTS idx_reviews_manga_id ON reviews(manga_id, created_at);
@@ -0,0 +1,5 @@
+-- Rollback manga rating aggregates
+DROP TRIGGER IF EXISTS trg_manga_ratings_delete;
+DROP TRIGGER IF EXISTS trg_manga_ratings_update;
+DROP TRIGGER IF EXISTS trg_manga_ratings_insert;
+DROP TABLE IF EXISTS manga_ratings;
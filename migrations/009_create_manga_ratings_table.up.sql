@@ -0,0 +1,89 @@
+-- Per-manga aggregate of the ratings stored in user_progress.
+-- r1..r10 hold the number of ratings for each score (the histogram).
+CREATE TABLE IF NOT EXISTS manga_ratings (
+    manga_id TEXT PRIMARY KEY,
+    rating_count INTEGER NOT NULL DEFAULT 0,
+    rating_sum INTEGER NOT NULL DEFAULT 0,
+    r1 INTEGER NOT NULL DEFAULT 0,
+    r2 INTEGER NOT NULL DEFAULT 0,
+    r3 INTEGER NOT NULL DEFAULT 0,
+    r4 INTEGER NOT NULL DEFAULT 0,
+    r5 INTEGER NOT NULL DEFAULT 0,
+    r6 INTEGER NOT NULL DEFAULT 0,
+    r7 INTEGER NOT NULL DEFAULT 0,
+    r8 INTEGER NOT NULL DEFAULT 0,
+    r9 INTEGER NOT NULL DEFAULT 0,
+    r10 INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE
+);
+
+-- Seed the aggregates from existing ratings
+INSERT INTO manga_ratings (manga_id, rating_count, rating_sum, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10)
+SELECT manga_id, COUNT(*), SUM(rating),
+    SUM(rating = 1), SUM(rating = 2), SUM(rating = 3), SUM(rating = 4), SUM(rating = 5), SUM(rating = 6), SUM(rating = 7), SUM(rating = 8), SUM(rating = 9), SUM(rating = 10)
+FROM user_progress
+WHERE rating IS NOT NULL
+GROUP BY manga_id;
+
+-- Keep the aggregates current as ratings are added, changed and removed
+CREATE TRIGGER IF NOT EXISTS trg_manga_ratings_insert
+AFTER INSERT ON user_progress
+WHEN NEW.rating IS NOT NULL
+BEGIN
+    INSERT OR IGNORE INTO manga_ratings (manga_id) VALUES (NEW.manga_id);
+    UPDATE manga_ratings SET
+        rating_count = rating_count + 1,
+        rating_sum = rating_sum + NEW.rating,
+        r1 = r1 + (NEW.rating = 1),
+        r2 = r2 + (NEW.rating = 2),
+        r3 = r3 + (NEW.rating = 3),
+        r4 = r4 + (NEW.rating = 4),
+        r5 = r5 + (NEW.rating = 5),
+        r6 = r6 + (NEW.rating = 6),
+        r7 = r7 + (NEW.rating = 7),
+        r8 = r8 + (NEW.rating = 8),
+        r9 = r9 + (NEW.rating = 9),
+        r10 = r10 + (NEW.rating = 10)
+    WHERE manga_id = NEW.manga_id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS trg_manga_ratings_update
+AFTER UPDATE OF rating ON user_progress
+WHEN OLD.rating IS NOT NEW.rating
+BEGIN
+    INSERT OR IGNORE INTO manga_ratings (manga_id) VALUES (NEW.manga_id);
+    UPDATE manga_ratings SET
+        rating_count = rating_count - (OLD.rating IS NOT NULL) + (NEW.rating IS NOT NULL),
+        rating_sum = rating_sum - COALESCE(OLD.rating, 0) + COALESCE(NEW.rating, 0),
+        r1 = r1 + (NEW.rating IS 1) - (OLD.rating IS 1),
+        r2 = r2 + (NEW.rating IS 2) - (OLD.rating IS 2),
+        r3 = r3 + (NEW.rating IS 3) - (OLD.rating IS 3),
+        r4 = r4 + (NEW.rating IS 4) - (OLD.rating IS 4),
+        r5 = r5 + (NEW.rating IS 5) - (OLD.rating IS 5),
+        r6 = r6 + (NEW.rating IS 6) - (OLD.rating IS 6),
+        r7 = r7 + (NEW.rating IS 7) - (OLD.rating IS 7),
+        r8 = r8 + (NEW.rating IS 8) - (OLD.rating IS 8),
+        r9 = r9 + (NEW.rating IS 9) - (OLD.rating IS 9),
+        r10 = r10 + (NEW.rating IS 10) - (OLD.rating IS 10)
+    WHERE manga_id = NEW.manga_id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS trg_manga_ratings_delete
+AFTER DELETE ON user_progress
+WHEN OLD.rating IS NOT NULL
+BEGIN
+    UPDATE manga_ratings SET
+        rating_count = rating_count - 1,
+        rating_sum = rating_sum - OLD.rating,
+        r1 = r1 - (OLD.rating = 1),
+        r2 = r2 - (OLD.rating = 2),
+        r3 = r3 - (OLD.rating = 3),
+        r4 = r4 - (OLD.rating = 4),
+        r5 = r5 - (OLD.rating = 5),
+        r6 = r6 - (OLD.rating = 6),
+        r7 = r7 - (OLD.rating = 7),
+        r8 = r8 - (OLD.rating = 8),
+        r9 = r9 - (OLD.rating = 9),
+        r10 = r10 - (OLD.rating = 10)
+    WHERE manga_id = OLD.manga_id;
+END;
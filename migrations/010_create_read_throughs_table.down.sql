@@ -0,0 +1,4 @@
+-- Rollback read-through tracking
+DROP INDEX IF EXISTS idx_read_throughs_entry;
+DROP TABLE IF EXISTS read_throughs;
+ALTER TABLE user_progress DROP COLUMN reread_count;
@@ -0,0 +1,18 @@
+-- Number of times a library entry was restarted after being completed
+ALTER TABLE user_progress ADD COLUMN reread_count INTEGER NOT NULL DEFAULT 0;
+
+-- Finished passes through a series, archived when the entry leaves "completed"
+CREATE TABLE IF NOT EXISTS read_throughs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    chapter REAL NOT NULL DEFAULT 0,
+    rating INTEGER CHECK(rating >= 1 AND rating <= 10),
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id, manga_id) REFERENCES user_progress(user_id, manga_id) ON DELETE CASCADE
+);
+
+-- Index for listing an entry's read-throughs
+CREATE INDEX IF NOT EXISTS idx_read_throughs_entry ON read_throughs(user_id, manga_id, id);
@@ -0,0 +1,2 @@
+-- Rollback progress versioning
+ALTER TABLE user_progress DROP COLUMN version;
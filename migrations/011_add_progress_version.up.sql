@@ -0,0 +1,2 @@
+-- Revision counter for optimistic concurrency; bumped on every progress write
+ALTER TABLE user_progress ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+-- Rollback the catalog change log
+DROP TRIGGER IF EXISTS trg_manga_changes_delete;
+DROP TRIGGER IF EXISTS trg_manga_changes_update;
+DROP TRIGGER IF EXISTS trg_manga_changes_insert;
+DROP TABLE IF EXISTS manga_changes;
@@ -0,0 +1,37 @@
+-- Ordered log of catalog writes, served by the catalog change feed.
+-- seq is the feed position; manga bodies are read from the manga table when served.
+CREATE TABLE IF NOT EXISTS manga_changes (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    manga_id TEXT NOT NULL,
+    change_type TEXT NOT NULL CHECK(change_type IN ('created', 'updated', 'deleted')),
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The existing catalog is the feed's starting point
+INSERT INTO manga_changes (manga_id, change_type, changed_at)
+SELECT id, 'created', COALESCE(created_at, CURRENT_TIMESTAMP)
+FROM manga
+ORDER BY created_at, id;
+
+-- Record every catalog write, whichever code path makes it
+CREATE TRIGGER IF NOT EXISTS trg_manga_changes_insert
+AFTER INSERT ON manga
+BEGIN
+    INSERT INTO manga_changes (manga_id, change_type) VALUES (NEW.id, 'created');
+END;
+
+CREATE TRIGGER IF NOT EXISTS trg_manga_changes_update
+AFTER UPDATE ON manga
+BEGIN
+    -- A renamed ID reads as the old manga going away and a new one appearing
+    INSERT INTO manga_changes (manga_id, change_type)
+    SELECT OLD.id, 'deleted' WHERE OLD.id IS NOT NEW.id;
+    INSERT INTO manga_changes (manga_id, change_type)
+    VALUES (NEW.id, CASE WHEN OLD.id IS NOT NEW.id THEN 'created' ELSE 'updated' END);
+END;
+
+CREATE TRIGGER IF NOT EXISTS trg_manga_changes_delete
+AFTER DELETE ON manga
+BEGIN
+    INSERT INTO manga_changes (manga_id, change_type) VALUES (OLD.id, 'deleted');
+END;
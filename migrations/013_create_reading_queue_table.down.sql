@@ -0,0 +1,5 @@
+-- Rollback reading queue, favorites and priorities
+DROP INDEX IF EXISTS idx_reading_queue_user_id;
+DROP TABLE IF EXISTS reading_queue;
+ALTER TABLE user_progress DROP COLUMN priority;
+ALTER TABLE user_progress DROP COLUMN favorite;
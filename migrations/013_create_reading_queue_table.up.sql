@@ -0,0 +1,16 @@
+-- Favorite flag and reading priority (0 none, 1 low, 2 medium, 3 high) on library entries
+ALTER TABLE user_progress ADD COLUMN favorite INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_progress ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+-- Manually ordered "up next" queue of library entries
+CREATE TABLE IF NOT EXISTS reading_queue (
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    added_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, manga_id),
+    FOREIGN KEY (user_id, manga_id) REFERENCES user_progress(user_id, manga_id) ON DELETE CASCADE
+);
+
+-- Index for listing a user's queue in order
+CREATE INDEX IF NOT EXISTS idx_reading_queue_user_id ON reading_queue(user_id, position);
@@ -0,0 +1,3 @@
+-- Rollback privacy settings
+ALTER TABLE user_progress DROP COLUMN hidden;
+ALTER TABLE users DROP COLUMN visibility;
@@ -0,0 +1,5 @@
+-- Who can see a user's profile, library and reading activity
+ALTER TABLE users ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public' CHECK(visibility IN ('public', 'followers', 'private'));
+
+-- Library entries kept out of the public library and activity even when the profile is visible
+ALTER TABLE user_progress ADD COLUMN hidden INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Rollback follows and activity events
+DROP INDEX IF EXISTS idx_activity_events_user_id;
+DROP TABLE IF EXISTS activity_events;
+DROP INDEX IF EXISTS idx_follows_followee_id;
+DROP TABLE IF EXISTS follows;
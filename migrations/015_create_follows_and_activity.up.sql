@@ -0,0 +1,30 @@
+-- Users following other users
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id TEXT NOT NULL,
+    followee_id TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id != followee_id),
+    FOREIGN KEY (follower_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (followee_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index for listing a user's followers
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows(followee_id, created_at);
+
+-- Reading activity shown in followers' feeds
+CREATE TABLE IF NOT EXISTS activity_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id TEXT NOT NULL,
+    manga_id TEXT NOT NULL,
+    event_type TEXT NOT NULL CHECK(event_type IN ('progress', 'completed', 'rated', 'reviewed')),
+    chapter REAL,
+    rating INTEGER,
+    review_id TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE
+);
+
+-- Index for reading a user's activity newest first
+CREATE INDEX IF NOT EXISTS idx_activity_events_user_id ON activity_events(user_id, created_at);
@@ -0,0 +1,5 @@
+-- Rollback chapter comments and user roles
+DROP INDEX IF EXISTS idx_chapter_comments_root_id;
+DROP INDEX IF EXISTS idx_chapter_comments_chapter;
+DROP TABLE IF EXISTS chapter_comments;
+ALTER TABLE users DROP COLUMN role;
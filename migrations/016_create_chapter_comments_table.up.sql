@@ -0,0 +1,28 @@
+-- User roles; admins may moderate content created by other users
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user' CHECK(role IN ('user', 'admin'));
+
+-- Threaded discussion of a manga chapter. root_id is the top-level comment of
+-- a reply's thread (NULL for top-level comments). Deleted comments keep their
+-- row so replies stay in place.
+CREATE TABLE IF NOT EXISTS chapter_comments (
+    id TEXT PRIMARY KEY,
+    manga_id TEXT NOT NULL,
+    chapter REAL NOT NULL,
+    user_id TEXT NOT NULL,
+    parent_id TEXT,
+    root_id TEXT,
+    body TEXT NOT NULL,
+    spoiler INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES chapter_comments(id) ON DELETE CASCADE
+);
+
+-- Index for listing a chapter's top-level comments
+CREATE INDEX IF NOT EXISTS idx_chapter_comments_chapter ON chapter_comments(manga_id, chapter, created_at);
+
+-- Index for loading the replies of a thread
+CREATE INDEX IF NOT EXISTS idx_chapter_comments_root_id ON chapter_comments(root_id, created_at);
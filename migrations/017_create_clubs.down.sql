@@ -0,0 +1,4 @@
+-- Rollback reading clubs
+DROP INDEX IF EXISTS idx_club_members_user_id;
+DROP TABLE IF EXISTS club_members;
+DROP TABLE IF EXISTS clubs;
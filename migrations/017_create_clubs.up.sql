@@ -0,0 +1,32 @@
+-- Reading clubs: a group of users reading one manga on a shared weekly schedule.
+-- Members should reach start_chapter + chapters_per_week chapters by the end of
+-- the first week after schedule_start, and chapters_per_week more every week after.
+-- reminded_week is the last schedule week members behind it were reminded for.
+CREATE TABLE IF NOT EXISTS clubs (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    owner_id TEXT NOT NULL,
+    manga_id TEXT,
+    start_chapter REAL NOT NULL DEFAULT 0,
+    chapters_per_week INTEGER NOT NULL DEFAULT 1 CHECK(chapters_per_week > 0),
+    schedule_start TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    reminded_week INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (manga_id) REFERENCES manga(id) ON DELETE SET NULL
+);
+
+-- Members of a club, including its owner
+CREATE TABLE IF NOT EXISTS club_members (
+    club_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (club_id, user_id),
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Index for listing a user's clubs
+CREATE INDEX IF NOT EXISTS idx_club_members_user_id ON club_members(user_id);
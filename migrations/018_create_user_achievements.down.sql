@@ -0,0 +1,2 @@
+-- Rollback achievements
+DROP TABLE IF EXISTS user_achievements;
@@ -0,0 +1,10 @@
+-- Achievements a user has unlocked. The achievements themselves and the rules
+-- that unlock them are defined in code; a row is written once and never removed,
+-- so badges stay unlocked when the reading that earned them is undone.
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id TEXT NOT NULL,
+    achievement_id TEXT NOT NULL,
+    unlocked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, achievement_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
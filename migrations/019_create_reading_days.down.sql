@@ -0,0 +1,2 @@
+-- Rollback reading days
+DROP TABLE IF EXISTS reading_days;
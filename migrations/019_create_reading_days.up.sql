@@ -0,0 +1,16 @@
+-- Whole chapters read per user per UTC day, for reading timelines and streaks.
+-- Filled from progress updates as they happen; earlier days are rebuilt from
+-- progress_history. Going back to an earlier chapter never subtracts.
+CREATE TABLE IF NOT EXISTS reading_days (
+    user_id TEXT NOT NULL,
+    day TEXT NOT NULL,
+    chapters INTEGER NOT NULL DEFAULT 0 CHECK(chapters >= 0),
+    PRIMARY KEY (user_id, day),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT OR IGNORE INTO reading_days (user_id, day, chapters)
+SELECT user_id, date(created_at), SUM(MAX(CAST(to_chapter AS INTEGER) - CAST(from_chapter AS INTEGER), 0))
+FROM progress_history
+GROUP BY user_id, date(created_at)
+HAVING SUM(MAX(CAST(to_chapter AS INTEGER) - CAST(from_chapter AS INTEGER), 0)) > 0;
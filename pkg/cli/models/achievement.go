@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Achievement represents an achievement with the user's progress toward it.
+type Achievement struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Metric      string     `json:"metric"`
+	Target      int        `json:"target"`
+	Current     int        `json:"current"`
+	Unlocked    bool       `json:"unlocked"`
+	UnlockedAt  *time.Time `json:"unlocked_at"`
+}
+
+// AchievementListResponse represents the response for the list of achievements.
+type AchievementListResponse struct {
+	Items    []Achievement `json:"items"`
+	Unlocked int           `json:"unlocked"`
+}
+
+// UnlockedAchievement represents an achievement shown on a profile.
+type UnlockedAchievement struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	UnlockedAt  time.Time `json:"unlocked_at"`
+}
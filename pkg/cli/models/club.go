@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// Club represents a reading club.
+type Club struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	Owner           string    `json:"owner"`
+	MangaID         *string   `json:"manga_id"`
+	MangaTitle      string    `json:"manga_title"`
+	StartChapter    float64   `json:"start_chapter"`
+	ChaptersPerWeek int       `json:"chapters_per_week"`
+	ScheduleStart   time.Time `json:"schedule_start"`
+	MemberCount     int       `json:"member_count"`
+	ChatRoom        string    `json:"chat_room"`
+}
+
+// ClubMember represents a member of a club.
+type ClubMember struct {
+	Username string    `json:"username"`
+	Owner    bool      `json:"owner"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// ClubDetails represents the response for a club with its members.
+type ClubDetails struct {
+	Club    Club         `json:"club"`
+	Members []ClubMember `json:"members"`
+}
+
+// ClubListResponse represents the response for a list of clubs.
+type ClubListResponse struct {
+	Items []Club `json:"items"`
+}
+
+// ClubMemberProgress represents where a member is against the club's schedule.
+type ClubMemberProgress struct {
+	Username       string   `json:"username"`
+	Chapter        *float64 `json:"chapter"`
+	Status         string   `json:"status"`
+	ChaptersBehind *float64 `json:"chapters_behind"`
+}
+
+// ClubProgress represents the response for a club's progress.
+type ClubProgress struct {
+	Club          Club                 `json:"club"`
+	Week          int                  `json:"week"`
+	DueChapter    *float64             `json:"due_chapter"`
+	TargetChapter *float64             `json:"target_chapter"`
+	Members       []ClubMemberProgress `json:"members"`
+}
+
+// ClubCreateRequest represents the request body for creating a club.
+type ClubCreateRequest struct {
+	Name            string  `json:"name"`
+	Description     string  `json:"description,omitempty"`
+	MangaID         *string `json:"manga_id,omitempty"`
+	StartChapter    float64 `json:"start_chapter,omitempty"`
+	ChaptersPerWeek int     `json:"chapters_per_week,omitempty"`
+}
+
+// ClubUpdateRequest represents the request body for changing a club.
+type ClubUpdateRequest struct {
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	MangaID         *string  `json:"manga_id,omitempty"`
+	StartChapter    *float64 `json:"start_chapter,omitempty"`
+	ChaptersPerWeek *int     `json:"chapters_per_week,omitempty"`
+}
+
+// ClubMemberRequest represents the request body for adding a club member.
+type ClubMemberRequest struct {
+	Username string `json:"username"`
+}
+
+// ClubRemindResponse represents the response for sending club reminders.
+type ClubRemindResponse struct {
+	Reminded int `json:"reminded"`
+}
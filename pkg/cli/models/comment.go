@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// ChapterComment represents a comment in a chapter's discussion, with its replies.
+type ChapterComment struct {
+	ID         string           `json:"id"`
+	ParentID   *string          `json:"parent_id"`
+	Username   string           `json:"username"`
+	Body       string           `json:"body"`
+	Spoiler    bool             `json:"spoiler"`
+	Deleted    bool             `json:"deleted"`
+	CreatedAt  time.Time        `json:"created_at"`
+	EditedAt   *time.Time       `json:"edited_at"`
+	ReplyCount int              `json:"reply_count"`
+	Replies    []ChapterComment `json:"replies"`
+}
+
+// CommentListResponse represents the response for a page of comment threads.
+type CommentListResponse struct {
+	Items []ChapterComment `json:"items"`
+}
+
+// CommentResponse represents the response for a single comment.
+type CommentResponse struct {
+	Comment ChapterComment `json:"comment"`
+}
+
+// CommentRequest represents the request body for posting a comment or reply.
+type CommentRequest struct {
+	Body     string  `json:"body"`
+	Spoiler  bool    `json:"spoiler"`
+	ParentID *string `json:"parent_id,omitempty"`
+}
+
+// CommentUpdateRequest represents the request body for editing a comment.
+type CommentUpdateRequest struct {
+	Body    string `json:"body"`
+	Spoiler *bool  `json:"spoiler,omitempty"`
+}
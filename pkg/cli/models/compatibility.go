@@ -0,0 +1,28 @@
+package models
+
+// Compatibility represents how alike the caller's and another user's reading taste is.
+type Compatibility struct {
+	Username          string            `json:"username"`
+	Score             *int              `json:"score"`
+	SharedTitles      int               `json:"shared_titles"`
+	RatedInCommon     int               `json:"rated_in_common"`
+	RatingCorrelation *float64          `json:"rating_correlation"`
+	GenreOverlap      *float64          `json:"genre_overlap"`
+	SharedCompletions int               `json:"shared_completions"`
+	CompletionOverlap *float64          `json:"completion_overlap"`
+	Agreements        []TitleComparison `json:"agreements"`
+	Disagreements     []TitleComparison `json:"disagreements"`
+}
+
+// TitleComparison represents a title both users rated.
+type TitleComparison struct {
+	MangaID     string `json:"manga_id"`
+	Title       string `json:"title"`
+	MyRating    int    `json:"my_rating"`
+	TheirRating int    `json:"their_rating"`
+}
+
+// CompatibilityResponse represents the response for a compatibility comparison.
+type CompatibilityResponse struct {
+	Compatibility Compatibility `json:"compatibility"`
+}
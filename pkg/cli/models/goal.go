@@ -0,0 +1,31 @@
+package models
+
+// Goal represents a reading goal with its progress in the current period.
+type Goal struct {
+	ID       string  `json:"id"`
+	Metric   string  `json:"metric"`
+	Genre    string  `json:"genre"`
+	Period   string  `json:"period"`
+	Target   int     `json:"target"`
+	Current  int     `json:"current"`
+	Percent  float64 `json:"percent"`
+	Achieved bool    `json:"achieved"`
+}
+
+// GoalListResponse represents the response for a list of goals.
+type GoalListResponse struct {
+	Items []Goal `json:"items"`
+}
+
+// GoalResponse represents the response for a single goal.
+type GoalResponse struct {
+	Goal Goal `json:"goal"`
+}
+
+// GoalRequest represents the request body for creating or updating a goal.
+type GoalRequest struct {
+	Metric *string `json:"metric,omitempty"`
+	Genre  *string `json:"genre,omitempty"`
+	Period *string `json:"period,omitempty"`
+	Target *int    `json:"target,omitempty"`
+}
@@ -2,9 +2,9 @@ package models
 
 // LibraryAddRequest represents the request body for adding a manga to the library.
 type LibraryAddRequest struct {
-	MangaID        string `json:"manga_id"`
-	Status         string `json:"status"`
-	CurrentChapter int    `json:"current_chapter"`
+	MangaID        string  `json:"manga_id"`
+	Status         string  `json:"status"`
+	CurrentChapter float64 `json:"current_chapter"`
 }
 
 // NullInt64 is a helper for unmarshalling sql.NullInt64 from JSON.
@@ -15,21 +15,97 @@ type NullInt64 struct {
 
 // UserProgress represents a user's progress on a manga.
 type UserProgress struct {
-	UserID         string `json:"user_id"`
-	MangaID        string `json:"manga_id"`
-	CurrentChapter int    `json:"current_chapter"`
-	Status         string `json:"status"`
-	Rating         *int   `json:"rating"`
-	UpdatedAt      string `json:"updated_at"` // Using string for simplicity, can be time.Time
+	UserID         string  `json:"user_id"`
+	MangaID        string  `json:"manga_id"`
+	CurrentChapter float64 `json:"current_chapter"`
+	Status         string  `json:"status"`
+	Rating         *int    `json:"rating"`
+	UpdatedAt      string  `json:"updated_at"` // Using string for simplicity, can be time.Time
+	RereadCount    int     `json:"reread_count"`
+	Version        int     `json:"version"`
+	Favorite       bool    `json:"favorite"`
+	Priority       int     `json:"priority"`
+	Hidden         bool    `json:"hidden"`
 }
 
 // UserProgressWithManga combines UserProgress with Manga details.
 type UserProgressWithManga struct {
 	UserProgress
-	Manga Manga `json:"manga"`
+	ProgressPercent *float64 `json:"progress_percent"`
+	Manga           Manga    `json:"manga"`
+	Shelves         []string `json:"shelves"`
+	Tags            []string `json:"tags"`
 }
 
 // LibraryListResponse represents the response for a list of library entries.
 type LibraryListResponse struct {
 	Items []UserProgressWithManga `json:"items"`
 }
+
+// LibraryEntryUpdateRequest represents the request body for starring, prioritizing or hiding an entry.
+type LibraryEntryUpdateRequest struct {
+	Favorite *bool `json:"favorite,omitempty"`
+	Priority *int  `json:"priority,omitempty"`
+	Hidden   *bool `json:"hidden,omitempty"`
+}
+
+// UpNextEntry represents a library entry suggested as something to read next.
+type UpNextEntry struct {
+	UserProgressWithManga
+	Rank           int      `json:"rank"`
+	Queued         bool     `json:"queued"`
+	UnreadChapters *float64 `json:"unread_chapters"`
+}
+
+// UpNextResponse represents the response for the up-next list.
+type UpNextResponse struct {
+	Items []UpNextEntry `json:"items"`
+}
+
+// QueueEntry represents a library entry in the manual reading queue.
+type QueueEntry struct {
+	UserProgressWithManga
+	Position int    `json:"position"`
+	AddedAt  string `json:"added_at"`
+}
+
+// QueueResponse represents the response for the reading queue.
+type QueueResponse struct {
+	Items []QueueEntry `json:"items"`
+}
+
+// QueueAddRequest represents the request body for adding an entry to the reading queue.
+type QueueAddRequest struct {
+	MangaID  string `json:"manga_id"`
+	Position *int   `json:"position,omitempty"`
+}
+
+// QueueOrderRequest represents the request body for reordering the reading queue.
+type QueueOrderRequest struct {
+	MangaIDs []string `json:"manga_ids"`
+}
+
+// ImportIssue describes an imported entry that was not written to the library.
+type ImportIssue struct {
+	Title    string `json:"title"`
+	SourceID string `json:"source_id"`
+	MangaID  string `json:"manga_id"`
+	Reason   string `json:"reason"`
+}
+
+// ImportResult summarizes a library import.
+type ImportResult struct {
+	Format    string        `json:"format"`
+	DryRun    bool          `json:"dry_run"`
+	Total     int           `json:"total"`
+	Added     int           `json:"added"`
+	Updated   int           `json:"updated"`
+	Skipped   []ImportIssue `json:"skipped"`
+	Unmatched []ImportIssue `json:"unmatched"`
+	Failed    []ImportIssue `json:"failed"`
+}
+
+// ImportResponse represents the response for a library import.
+type ImportResponse struct {
+	Result ImportResult `json:"result"`
+}
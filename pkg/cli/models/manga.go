@@ -14,6 +14,16 @@ type Manga struct {
 	CoverImageURL string    `json:"cover_image_url"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	// CommunityRating is nil until someone rates the manga
+	CommunityRating *CommunityRating `json:"community_rating"`
+}
+
+// CommunityRating aggregates the ratings users have given a manga.
+type CommunityRating struct {
+	Count     int     `json:"count"`
+	Mean      float64 `json:"mean"`
+	Score     float64 `json:"score"`
+	Histogram [10]int `json:"histogram"`
 }
 
 // MangaSearchQuery represents the query parameters for manga search.
@@ -22,6 +32,7 @@ type MangaSearchQuery struct {
 	Author string `json:"author"`
 	Genre  string `json:"genre"`
 	Status string `json:"status"`
+	Sort   string `json:"sort"`
 	Limit  int    `json:"limit"`
 	Offset int    `json:"offset"`
 }
@@ -45,3 +56,19 @@ type MangaListResponse struct {
 type MangaDetailResponse struct {
 	Manga Manga `json:"manga"`
 }
+
+// MangaChange is one entry in the catalog change feed.
+type MangaChange struct {
+	Token     string    `json:"token"`
+	Type      string    `json:"type"`
+	MangaID   string    `json:"manga_id"`
+	ChangedAt time.Time `json:"changed_at"`
+	Manga     *Manga    `json:"manga"`
+}
+
+// MangaChangesPage represents a page of the catalog change feed.
+type MangaChangesPage struct {
+	Changes   []MangaChange `json:"changes"`
+	NextSince string        `json:"next_since"`
+	HasMore   bool          `json:"has_more"`
+}
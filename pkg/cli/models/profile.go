@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// PublicProfile represents what other users can see of a user.
+type PublicProfile struct {
+	Username     string                `json:"username"`
+	Visibility   string                `json:"visibility"`
+	JoinedAt     time.Time             `json:"joined_at"`
+	Restricted   bool                  `json:"restricted"`
+	Library      *LibrarySummary       `json:"library"`
+	Achievements []UnlockedAchievement `json:"achievements"`
+}
+
+// LibrarySummary represents the counts of a library the viewer can see.
+type LibrarySummary struct {
+	Total     int            `json:"total"`
+	ByStatus  map[string]int `json:"by_status"`
+	Favorites int            `json:"favorites"`
+}
+
+// ProfileResponse represents the response for a public profile.
+type ProfileResponse struct {
+	Profile PublicProfile `json:"profile"`
+}
+
+// PrivacyUpdateRequest represents the request body for changing who can see your profile.
+type PrivacyUpdateRequest struct {
+	Visibility string `json:"visibility"`
+}
+
+// UserResponse represents the response for the current user.
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// FollowedUser represents an entry of a follower or following list.
+type FollowedUser struct {
+	Username   string    `json:"username"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// FollowListResponse represents the response for a follower or following list.
+type FollowListResponse struct {
+	Items []FollowedUser `json:"items"`
+}
+
+// ActivityEvent represents a piece of a followed user's reading activity.
+type ActivityEvent struct {
+	ID         int64     `json:"id"`
+	Username   string    `json:"username"`
+	Type       string    `json:"type"`
+	MangaID    string    `json:"manga_id"`
+	MangaTitle string    `json:"manga_title"`
+	Chapter    *float64  `json:"chapter"`
+	Rating     *int      `json:"rating"`
+	ReviewID   *string   `json:"review_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FeedResponse represents the response for the activity feed.
+type FeedResponse struct {
+	Items []ActivityEvent `json:"items"`
+}
@@ -1,8 +1,22 @@
 package models
 
+import "time"
+
 // ProgressUpdateRequest represents the request body for updating reading progress.
+// A nil chapter leaves the current chapter unchanged.
 type ProgressUpdateRequest struct {
-	CurrentChapter int    `json:"current_chapter"`
-	Status         string `json:"status,omitempty"`
-	Rating         *int   `json:"rating,omitempty"`
+	CurrentChapter *float64 `json:"current_chapter,omitempty"`
+	Status         string   `json:"status,omitempty"`
+	Rating         *int     `json:"rating,omitempty"`
+	OnConflict     string   `json:"on_conflict,omitempty"`
+}
+
+// ReadThrough represents one pass through a series.
+type ReadThrough struct {
+	Number      int        `json:"number"`
+	Chapter     float64    `json:"chapter"`
+	Rating      *int       `json:"rating"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Current     bool       `json:"current"`
 }
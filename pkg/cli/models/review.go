@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Review represents a user's written review of a manga.
+type Review struct {
+	ID           string    `json:"id"`
+	Username     string    `json:"username"`
+	MangaID      string    `json:"manga_id"`
+	MangaTitle   string    `json:"manga_title"`
+	Body         string    `json:"body"`
+	Spoiler      bool      `json:"spoiler"`
+	Rating       *int      `json:"rating"`
+	HelpfulCount int       `json:"helpful_count"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ReviewListResponse represents the response for a list of reviews.
+type ReviewListResponse struct {
+	Items []Review `json:"items"`
+}
+
+// ReviewResponse represents the response for a single review.
+type ReviewResponse struct {
+	Review Review `json:"review"`
+}
+
+// ReviewRequest represents the request body for writing a review.
+type ReviewRequest struct {
+	Body    string `json:"body"`
+	Spoiler bool   `json:"spoiler"`
+}
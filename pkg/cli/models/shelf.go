@@ -0,0 +1,58 @@
+package models
+
+// Shelf represents a user-defined shelf of library entries.
+type Shelf struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Position   int    `json:"position"`
+	Visibility string `json:"visibility"`
+	EntryCount int    `json:"entry_count"`
+}
+
+// ShelfListResponse represents the response for a list of shelves.
+type ShelfListResponse struct {
+	Items []Shelf `json:"items"`
+}
+
+// ShelfResponse represents the response for a single shelf.
+type ShelfResponse struct {
+	Shelf Shelf `json:"shelf"`
+}
+
+// ShelfCreateRequest represents the request body for creating a shelf.
+type ShelfCreateRequest struct {
+	Name       string `json:"name"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// ShelfUpdateRequest represents the request body for updating a shelf.
+type ShelfUpdateRequest struct {
+	Name       *string `json:"name,omitempty"`
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// ShelfOrderRequest represents the request body for reordering shelves.
+type ShelfOrderRequest struct {
+	ShelfIDs []string `json:"shelf_ids"`
+}
+
+// ShelfEntryRequest represents the request body for putting a manga on a shelf.
+type ShelfEntryRequest struct {
+	MangaID string `json:"manga_id"`
+}
+
+// TagsUpdateRequest represents the request body for replacing an entry's tags.
+type TagsUpdateRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// TagCount represents a tag and the number of entries using it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// TagListResponse represents the response for a list of tags.
+type TagListResponse struct {
+	Items []TagCount `json:"items"`
+}
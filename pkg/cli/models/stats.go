@@ -0,0 +1,55 @@
+package models
+
+// TimelinePoint represents the chapters read in one bucket of a reading timeline.
+type TimelinePoint struct {
+	Start    string `json:"start"`
+	Chapters int    `json:"chapters"`
+}
+
+// ReadingStreak represents a user's current and longest runs of reading days.
+type ReadingStreak struct {
+	Current    int     `json:"current"`
+	Longest    int     `json:"longest"`
+	LastReadOn *string `json:"last_read_on"`
+}
+
+// ReadingTimeline represents the response for a reading timeline.
+type ReadingTimeline struct {
+	Bucket        string          `json:"bucket"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	Points        []TimelinePoint `json:"points"`
+	TotalChapters int             `json:"total_chapters"`
+	ActiveDays    int             `json:"active_days"`
+	Streak        ReadingStreak   `json:"streak"`
+}
+
+// CompositionGroup represents the library entries sharing a genre, author or publication status.
+type CompositionGroup struct {
+	Name           string   `json:"name"`
+	Titles         int      `json:"titles"`
+	Started        int      `json:"started"`
+	Completed      int      `json:"completed"`
+	Dropped        int      `json:"dropped"`
+	ChaptersRead   int      `json:"chapters_read"`
+	Rated          int      `json:"rated"`
+	AverageRating  *float64 `json:"average_rating"`
+	CompletionRate *float64 `json:"completion_rate"`
+	DroppedRate    *float64 `json:"dropped_rate"`
+}
+
+// RatingHistogram represents how often each rating was given.
+type RatingHistogram struct {
+	Counts        []int    `json:"counts"`
+	Rated         int      `json:"rated"`
+	AverageRating *float64 `json:"average_rating"`
+}
+
+// LibraryComposition represents the response for library composition statistics.
+type LibraryComposition struct {
+	Titles              int                `json:"titles"`
+	Genres              []CompositionGroup `json:"genres"`
+	Authors             []CompositionGroup `json:"authors"`
+	PublicationStatuses []CompositionGroup `json:"publication_statuses"`
+	Ratings             RatingHistogram    `json:"ratings"`
+}
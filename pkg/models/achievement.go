@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// AchievementMetric is what an achievement rule measures
+type AchievementMetric string
+
+const (
+	AchievementMetricCompletedSeries AchievementMetric = "completed_series" // Series ever completed
+	AchievementMetricChaptersRead    AchievementMetric = "chapters_read"    // Chapters read across the library
+	AchievementMetricWeeklyChapters  AchievementMetric = "weekly_chapters"  // Chapters read in the last 7 days
+	AchievementMetricGenresRead      AchievementMetric = "genres_read"      // Genres of the series started
+	AchievementMetricRatedSeries     AchievementMetric = "rated_series"     // Library entries with a rating
+)
+
+// AchievementMetrics holds the current value of every metric for a user
+type AchievementMetrics map[AchievementMetric]int
+
+// Achievement is a badge unlocked once Metric reaches Target
+type Achievement struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Metric      AchievementMetric `json:"metric"`
+	Target      int               `json:"target"`
+}
+
+// UnlockedAchievement is an achievement a user has unlocked
+type UnlockedAchievement struct {
+	Achievement
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// AchievementProgress is an achievement with how far a user is from unlocking it.
+// Current stops at Target, and stays there once the achievement is unlocked.
+type AchievementProgress struct {
+	Achievement
+	Current    int        `json:"current"`
+	Unlocked   bool       `json:"unlocked"`
+	UnlockedAt *time.Time `json:"unlocked_at"`
+}
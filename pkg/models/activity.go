@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// ActivityType is the kind of reading activity an event records
+type ActivityType string
+
+const (
+	ActivityProgress  ActivityType = "progress"  // Read further into a series
+	ActivityCompleted ActivityType = "completed" // Finished a series
+	ActivityRated     ActivityType = "rated"     // Rated a series
+	ActivityReviewed  ActivityType = "reviewed"  // Wrote a review
+)
+
+// ActivityEvent is a piece of a user's reading activity shown in their followers' feeds
+type ActivityEvent struct {
+	ID         int64          `json:"id" db:"id"`
+	UserID     string         `json:"user_id" db:"user_id"`
+	Username   string         `json:"username"`
+	Type       ActivityType   `json:"type" db:"event_type"`
+	MangaID    string         `json:"manga_id" db:"manga_id"`
+	MangaTitle string         `json:"manga_title"`
+	Chapter    *ChapterNumber `json:"chapter,omitempty" db:"chapter"`
+	Rating     *int           `json:"rating,omitempty" db:"rating"`
+	ReviewID   *string        `json:"review_id,omitempty" db:"review_id"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+}
+
+// FeedQuery represents paging for the activity feed
+type FeedQuery struct {
+	Limit  int `form:"limit" binding:"omitempty,min=0,max=100"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
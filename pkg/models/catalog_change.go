@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// Types of catalog change reported by the change feed
+const (
+	MangaChangeCreated = "created"
+	MangaChangeUpdated = "updated"
+	MangaChangeDeleted = "deleted"
+)
+
+// Default and maximum number of changes returned per page of the feed
+const (
+	DefaultMangaChangesLimit = 100
+	MaxMangaChangesLimit     = 500
+)
+
+// MangaChange is one entry in the catalog change feed
+type MangaChange struct {
+	// Token resumes the feed right after this change
+	Token     string    `json:"token"`
+	Type      string    `json:"type"`
+	MangaID   string    `json:"manga_id"`
+	ChangedAt time.Time `json:"changed_at"`
+	// Manga is the current body; nil for deletions and for manga deleted since
+	Manga *Manga `json:"manga"`
+}
+
+// MangaChangesQuery represents the parameters for reading the change feed
+type MangaChangesQuery struct {
+	// Since is a token from an earlier page; empty starts from the beginning
+	Since string `form:"since"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+// MangaChangesPage is a page of the catalog change feed
+type MangaChangesPage struct {
+	Changes []MangaChange `json:"changes"`
+	// NextSince is the token to pass as since for the following page
+	NextSince string `json:"next_since"`
+	HasMore   bool   `json:"has_more"`
+}
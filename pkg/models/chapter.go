@@ -0,0 +1,138 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ChapterNumber is a position in a manga's chapter list. Besides whole
+// chapters it covers extras such as 10.5 and prologues numbered 0.
+// Values are limited to two decimal places so they survive JSON, SQLite REAL
+// and protobuf double round trips unchanged.
+type ChapterNumber float64
+
+// ParseChapterNumber parses a chapter number such as "12", "10.5" or "0"
+func ParseChapterNumber(s string) (ChapterNumber, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chapter number: %q", s)
+	}
+	return NewChapterNumber(v)
+}
+
+// NewChapterNumber validates a chapter number given as a float
+func NewChapterNumber(v float64) (ChapterNumber, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid chapter number: not a number")
+	}
+	if v < 0 {
+		return 0, fmt.Errorf("invalid chapter number: cannot be negative")
+	}
+	rounded := math.Round(v * 100)
+	if math.Abs(v*100-rounded) > 1e-6 {
+		return 0, fmt.Errorf("invalid chapter number: at most two decimal places")
+	}
+	return ChapterNumber(rounded / 100), nil
+}
+
+// Float64 returns the chapter number as a float
+func (c ChapterNumber) Float64() float64 {
+	return float64(c)
+}
+
+// Whole returns the number of whole chapters up to and including this one
+func (c ChapterNumber) Whole() int {
+	return int(math.Floor(float64(c)))
+}
+
+// Exceeds reports whether the chapter is past the last chapter of a series.
+// An unknown total (0 or less) is never exceeded.
+func (c ChapterNumber) Exceeds(totalChapters int) bool {
+	return totalChapters > 0 && float64(c) > float64(totalChapters)
+}
+
+// Reaches reports whether the chapter is at or past the last chapter of a series
+func (c ChapterNumber) Reaches(totalChapters int) bool {
+	return totalChapters > 0 && float64(c) >= float64(totalChapters)
+}
+
+// PercentOf returns how far through a series the chapter is, from 0 to 100 with
+// one decimal place. It returns nil when the total is unknown (0 or less).
+func (c ChapterNumber) PercentOf(totalChapters int) *float64 {
+	if totalChapters <= 0 {
+		return nil
+	}
+	percent := math.Min(float64(c)/float64(totalChapters)*100, 100)
+	percent = math.Round(percent*10) / 10
+	return &percent
+}
+
+// UnreadOf returns how many chapters of a series are left after this one, never
+// less than 0. It returns nil when the total is unknown (0 or less).
+func (c ChapterNumber) UnreadOf(totalChapters int) *float64 {
+	if totalChapters <= 0 {
+		return nil
+	}
+	unread := math.Max(float64(totalChapters)-float64(c), 0)
+	unread = math.Round(unread*100) / 100
+	return &unread
+}
+
+// String formats the chapter number without trailing zeros ("10", "10.5")
+func (c ChapterNumber) String() string {
+	return strconv.FormatFloat(float64(c), 'f', -1, 64)
+}
+
+// MarshalJSON encodes the chapter number as a JSON number
+func (c ChapterNumber) MarshalJSON() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a numeric string
+func (c *ChapterNumber) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if string(data) == "null" {
+		return nil
+	}
+	parsed, err := ParseChapterNumber(string(data))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// Value stores the chapter number as a SQLite REAL
+func (c ChapterNumber) Value() (driver.Value, error) {
+	return float64(c), nil
+}
+
+// Scan reads a chapter number stored as INTEGER, REAL or TEXT
+func (c *ChapterNumber) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*c = 0
+	case int64:
+		*c = ChapterNumber(v)
+	case float64:
+		*c = ChapterNumber(math.Round(v*100) / 100)
+	case []byte:
+		parsed, err := ParseChapterNumber(string(v))
+		if err != nil {
+			return err
+		}
+		*c = parsed
+	case string:
+		parsed, err := ParseChapterNumber(v)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+	default:
+		return fmt.Errorf("cannot scan %T into ChapterNumber", src)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// Club schedule statuses of a member
+const (
+	ClubMemberBehind     = "behind"      // Has not reached the chapter due by the end of last week
+	ClubMemberOnTrack    = "on_track"    // Reached last week's chapter but not this week's
+	ClubMemberAhead      = "ahead"       // Reached this week's chapter
+	ClubMemberNotStarted = "not_started" // The manga is not in the member's library
+	ClubMemberHidden     = "hidden"      // The member's privacy settings hide their progress
+)
+
+// Club is a group of users reading a manga together on a weekly schedule
+type Club struct {
+	ID              string        `json:"id" db:"id"`
+	Name            string        `json:"name" db:"name"`
+	Description     string        `json:"description" db:"description"`
+	OwnerID         string        `json:"owner_id" db:"owner_id"`
+	Owner           string        `json:"owner"`                  // Owner's username
+	MangaID         *string       `json:"manga_id" db:"manga_id"` // NULL between series
+	MangaTitle      string        `json:"manga_title,omitempty"`
+	StartChapter    ChapterNumber `json:"start_chapter" db:"start_chapter"`
+	ChaptersPerWeek int           `json:"chapters_per_week" db:"chapters_per_week"`
+	ScheduleStart   time.Time     `json:"schedule_start" db:"schedule_start"`
+	RemindedWeek    int           `json:"-" db:"reminded_week"` // Last schedule week reminders went out for
+	MemberCount     int           `json:"member_count"`
+	ChatRoom        string        `json:"chat_room"` // WebSocket room only members can join
+	CreatedAt       time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// ClubChatRoom returns the name of a club's chat room
+func ClubChatRoom(clubID string) string {
+	return "club-" + clubID
+}
+
+// ScheduleWeek returns the week of the schedule t falls in, counting from 1.
+// It returns 0 before the schedule starts.
+func (c *Club) ScheduleWeek(t time.Time) int {
+	elapsed := t.Sub(c.ScheduleStart)
+	if elapsed < 0 {
+		return 0
+	}
+	return int(elapsed/(7*24*time.Hour)) + 1
+}
+
+// TargetChapter returns the chapter members should reach by the end of the
+// given schedule week, capped at totalChapters when the count is known
+func (c *Club) TargetChapter(week, totalChapters int) ChapterNumber {
+	target := float64(c.StartChapter) + float64(week*c.ChaptersPerWeek)
+	if totalChapters > 0 {
+		target = math.Min(target, float64(totalChapters))
+	}
+	return ChapterNumber(target)
+}
+
+// ClubMember is a member of a club
+type ClubMember struct {
+	Username string    `json:"username"`
+	Owner    bool      `json:"owner"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// ClubDetails is a club with its members
+type ClubDetails struct {
+	Club    Club         `json:"club"`
+	Members []ClubMember `json:"members"`
+}
+
+// ClubMemberProgress is where a member is in the club's manga
+type ClubMemberProgress struct {
+	Username       string         `json:"username"`
+	Chapter        *ChapterNumber `json:"chapter"` // NULL when not started or hidden
+	Status         string         `json:"status"`
+	ChaptersBehind *float64       `json:"chapters_behind,omitempty"` // Set when behind
+}
+
+// ClubProgress compares the members of a club with its schedule
+type ClubProgress struct {
+	Club          Club                 `json:"club"`
+	Week          int                  `json:"week"`
+	DueChapter    *ChapterNumber       `json:"due_chapter"`    // Due by the end of last week
+	TargetChapter *ChapterNumber       `json:"target_chapter"` // Due by the end of this week
+	Members       []ClubMemberProgress `json:"members"`
+}
+
+// ClubCreateRequest represents data for creating a club
+type ClubCreateRequest struct {
+	Name            string        `json:"name" binding:"required,max=100"`
+	Description     string        `json:"description" binding:"max=1000"`
+	MangaID         *string       `json:"manga_id"`
+	StartChapter    ChapterNumber `json:"start_chapter"`
+	ChaptersPerWeek int           `json:"chapters_per_week" binding:"omitempty,min=1,max=1000"` // Defaults to 1
+}
+
+// ClubUpdateRequest represents changes to a club. Changing the manga, start
+// chapter or pace restarts the schedule from now.
+type ClubUpdateRequest struct {
+	Name            *string        `json:"name" binding:"omitempty,min=1,max=100"`
+	Description     *string        `json:"description" binding:"omitempty,max=1000"`
+	MangaID         *string        `json:"manga_id"` // Empty string clears the manga
+	StartChapter    *ChapterNumber `json:"start_chapter"`
+	ChaptersPerWeek *int           `json:"chapters_per_week" binding:"omitempty,min=1,max=1000"`
+}
+
+// ClubMemberRequest represents adding a member to a club
+type ClubMemberRequest struct {
+	Username string `json:"username" binding:"required"`
+}
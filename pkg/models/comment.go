@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// Comment sort keys
+const (
+	CommentSortNewest = "newest"
+	CommentSortOldest = "oldest"
+)
+
+// ChapterComment is a comment in the discussion of a manga chapter. Replies are
+// nested under the comment they answer. A deleted comment keeps its place in
+// the thread with its author and body left out.
+type ChapterComment struct {
+	ID         string           `json:"id" db:"id"`
+	MangaID    string           `json:"manga_id" db:"manga_id"`
+	Chapter    ChapterNumber    `json:"chapter" db:"chapter"`
+	ParentID   *string          `json:"parent_id" db:"parent_id"`
+	UserID     string           `json:"user_id,omitempty" db:"user_id"`
+	Username   string           `json:"username,omitempty"`
+	Body       string           `json:"body" db:"body"`
+	Spoiler    bool             `json:"spoiler" db:"spoiler"`
+	Deleted    bool             `json:"deleted"`
+	CreatedAt  time.Time        `json:"created_at" db:"created_at"`
+	EditedAt   *time.Time       `json:"edited_at" db:"edited_at"`
+	ReplyCount int              `json:"reply_count"` // Replies anywhere below this comment
+	Replies    []ChapterComment `json:"replies"`
+}
+
+// CommentRequest represents data for posting a comment or a reply
+type CommentRequest struct {
+	Body     string  `json:"body" binding:"required,max=5000"`
+	Spoiler  bool    `json:"spoiler"`
+	ParentID *string `json:"parent_id"` // Comment being replied to
+}
+
+// CommentUpdateRequest represents an edit of a comment
+type CommentUpdateRequest struct {
+	Body    string `json:"body" binding:"required,max=5000"`
+	Spoiler *bool  `json:"spoiler"`
+}
+
+// CommentQuery represents sorting and paging for listing a chapter's comments
+type CommentQuery struct {
+	Sort   string `form:"sort" binding:"omitempty,oneof=newest oldest"`
+	Limit  int    `form:"limit" binding:"omitempty,min=0,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// Compatibility compares the reading taste of the caller with another user.
+// Components are nil when the two libraries give them nothing to work with,
+// and Score is nil when every component is.
+type Compatibility struct {
+	Username          string            `json:"username"`
+	Score             *int              `json:"score"`         // 0-100
+	SharedTitles      int               `json:"shared_titles"` // Titles in both libraries
+	RatedInCommon     int               `json:"rated_in_common"`
+	RatingCorrelation *float64          `json:"rating_correlation"` // -1 to 1 over titles both rated
+	GenreOverlap      *float64          `json:"genre_overlap"`      // 0 to 1, similarity of genres read
+	SharedCompletions int               `json:"shared_completions"`
+	CompletionOverlap *float64          `json:"completion_overlap"` // 0 to 1, shared share of all completions
+	Agreements        []TitleComparison `json:"agreements"`
+	Disagreements     []TitleComparison `json:"disagreements"`
+}
+
+// TitleComparison is a title both users rated
+type TitleComparison struct {
+	MangaID     string `json:"manga_id"`
+	Title       string `json:"title"`
+	MyRating    int    `json:"my_rating"`
+	TheirRating int    `json:"their_rating"`
+}
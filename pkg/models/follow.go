@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// FollowedUser is an entry of a follower or following list
+type FollowedUser struct {
+	Username   string    `json:"username"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// FollowListQuery represents paging for follower and following lists
+type FollowListQuery struct {
+	Limit  int `form:"limit" binding:"omitempty,min=0,max=100"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// GoalMetric is what a reading goal counts
+type GoalMetric string
+
+const (
+	GoalMetricChapters        GoalMetric = "chapters"         // Chapters read during the period
+	GoalMetricCompletedSeries GoalMetric = "completed_series" // Series completed during the period
+)
+
+// GoalPeriod is the window a reading goal is measured over. Goals repeat every period.
+type GoalPeriod string
+
+const (
+	GoalPeriodWeek  GoalPeriod = "week"
+	GoalPeriodMonth GoalPeriod = "month"
+	GoalPeriodYear  GoalPeriod = "year"
+)
+
+// Window returns the start (inclusive) and end (exclusive) of the period containing t,
+// using ISO weeks that start on Monday
+func (p GoalPeriod) Window(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	switch p {
+	case GoalPeriodWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7)
+	case GoalPeriodMonth:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0)
+	}
+}
+
+// Key identifies the period containing t, e.g. "2026", "2026-10" or "2026-W42"
+func (p GoalPeriod) Key(t time.Time) string {
+	t = t.UTC()
+	switch p {
+	case GoalPeriodWeek:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case GoalPeriodMonth:
+		return t.Format("2006-01")
+	default:
+		return t.Format("2006")
+	}
+}
+
+// ReadingGoal is a target a user sets for a recurring period
+type ReadingGoal struct {
+	ID             string     `json:"id" db:"id"`
+	UserID         string     `json:"user_id" db:"user_id"`
+	Metric         GoalMetric `json:"metric" db:"metric"`
+	Genre          string     `json:"genre,omitempty" db:"genre"` // Only count manga in this genre
+	Period         GoalPeriod `json:"period" db:"period"`
+	Target         int        `json:"target" db:"target"`
+	AchievedPeriod string     `json:"-" db:"achieved_period"` // Key of the last period the goal was reached in
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// GoalProgress reports how far a goal is in the current period
+type GoalProgress struct {
+	ReadingGoal
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Current     int       `json:"current"`
+	Percent     float64   `json:"percent"`
+	Achieved    bool      `json:"achieved"`
+}
+
+// GoalCreateRequest represents data for creating a reading goal
+type GoalCreateRequest struct {
+	Metric GoalMetric `json:"metric" binding:"required,oneof=chapters completed_series"`
+	Genre  string     `json:"genre" binding:"max=64"`
+	Period GoalPeriod `json:"period" binding:"required,oneof=week month year"`
+	Target int        `json:"target" binding:"required,min=1,max=100000"`
+}
+
+// GoalUpdateRequest represents data for changing a reading goal
+type GoalUpdateRequest struct {
+	Metric *GoalMetric `json:"metric" binding:"omitempty,oneof=chapters completed_series"`
+	Genre  *string     `json:"genre" binding:"omitempty,max=64"`
+	Period *GoalPeriod `json:"period" binding:"omitempty,oneof=week month year"`
+	Target *int        `json:"target" binding:"omitempty,min=1,max=100000"`
+}
@@ -0,0 +1,41 @@
+package models
+
+// Library import/export formats
+const (
+	ImportFormatMAL  = "mal"
+	ExportFormatMAL  = "mal"
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+)
+
+// LibraryExportQuery represents options for exporting a library
+type LibraryExportQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=mal csv json"`
+}
+
+// LibraryImportQuery represents options for importing a library file
+type LibraryImportQuery struct {
+	Format    string `form:"format" binding:"omitempty,oneof=mal"`
+	Overwrite bool   `form:"overwrite"` // Update entries already in the library
+	DryRun    bool   `form:"dry_run"`   // Match and validate without writing anything
+}
+
+// ImportIssue describes an imported entry that was not written to the library
+type ImportIssue struct {
+	Title    string `json:"title"`
+	SourceID string `json:"source_id,omitempty"`
+	MangaID  string `json:"manga_id,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+// ImportResult summarizes a library import
+type ImportResult struct {
+	Format    string        `json:"format"`
+	DryRun    bool          `json:"dry_run"`
+	Total     int           `json:"total"`
+	Added     int           `json:"added"`
+	Updated   int           `json:"updated"`
+	Skipped   []ImportIssue `json:"skipped"`   // Already in the library
+	Unmatched []ImportIssue `json:"unmatched"` // No catalog entry with that title or alias
+	Failed    []ImportIssue `json:"failed"`    // Matched but rejected by the library rules
+}
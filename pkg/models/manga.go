@@ -27,6 +27,8 @@ type Manga struct {
 	CoverImageURL string      `json:"cover_image_url" db:"cover_image_url"`
 	CreatedAt     time.Time   `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time   `json:"updated_at" db:"updated_at"`
+	// CommunityRating is nil until someone rates the manga
+	CommunityRating *CommunityRating `json:"community_rating"`
 }
 
 // MangaCreateRequest represents data for creating a new manga
@@ -52,14 +54,24 @@ type MangaUpdateRequest struct {
 	CoverImageURL *string      `json:"cover_image_url"`
 }
 
+// Sort keys accepted by MangaSearchQuery.Sort
+const (
+	MangaSortTitle   = "title"
+	MangaSortScore   = "score"
+	MangaSortUpdated = "updated"
+)
+
 // MangaSearchQuery represents search parameters
 type MangaSearchQuery struct {
 	Title  string      `form:"title"`
 	Author string      `form:"author"`
 	Genre  string      `form:"genre"`
 	Status MangaStatus `form:"status"`
-	Limit  int         `form:"limit"`
-	Offset int         `form:"offset"`
+	Sort   string      `form:"sort" binding:"omitempty,oneof=title score updated"`
+	// UpdatedSince keeps manga changed at or after this time (RFC 3339)
+	UpdatedSince time.Time `form:"updated_since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit        int       `form:"limit"`
+	Offset       int       `form:"offset"`
 }
 
 // MarshalGenres converts genres slice to JSON string for database storage
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// PublicProfile is what other users can see of a user. When the viewer may not
+// see the user's library, Restricted is set and Library and Achievements are left out.
+type PublicProfile struct {
+	Username     string                `json:"username"`
+	Visibility   ProfileVisibility     `json:"visibility"`
+	JoinedAt     time.Time             `json:"joined_at"`
+	Restricted   bool                  `json:"restricted"`
+	Library      *LibrarySummary       `json:"library,omitempty"`
+	Achievements []UnlockedAchievement `json:"achievements,omitempty"`
+}
+
+// LibrarySummary counts the entries of a library the viewer can see
+type LibrarySummary struct {
+	Total     int                   `json:"total"`
+	ByStatus  map[ReadingStatus]int `json:"by_status"`
+	Favorites int                   `json:"favorites"`
+}
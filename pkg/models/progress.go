@@ -16,36 +16,152 @@ const (
 	ReadingStatusDropped    ReadingStatus = "dropped"
 )
 
+// readingStatusTransitions lists the statuses a library entry may move to from each status
+var readingStatusTransitions = map[ReadingStatus][]ReadingStatus{
+	ReadingStatusPlanToRead: {ReadingStatusReading, ReadingStatusOnHold, ReadingStatusDropped, ReadingStatusCompleted},
+	ReadingStatusReading:    {ReadingStatusOnHold, ReadingStatusDropped, ReadingStatusCompleted},
+	ReadingStatusOnHold:     {ReadingStatusReading, ReadingStatusDropped, ReadingStatusCompleted},
+	ReadingStatusDropped:    {ReadingStatusReading, ReadingStatusPlanToRead},
+	ReadingStatusCompleted:  {ReadingStatusReading, ReadingStatusPlanToRead},
+}
+
+// IsValid reports whether the status is one of the known reading statuses
+func (s ReadingStatus) IsValid() bool {
+	_, ok := readingStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether a library entry may move from this status to next.
+// Staying on the same status is always allowed.
+func (s ReadingStatus) CanTransitionTo(next ReadingStatus) bool {
+	if s == next {
+		return next.IsValid()
+	}
+	for _, allowed := range readingStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 // UserProgress represents a user's reading progress for a manga
 type UserProgress struct {
 	UserID         string        `json:"user_id" db:"user_id"`
 	MangaID        string        `json:"manga_id" db:"manga_id"`
-	CurrentChapter int           `json:"current_chapter" db:"current_chapter"`
+	CurrentChapter ChapterNumber `json:"current_chapter" db:"current_chapter"`
 	Status         ReadingStatus `json:"status" db:"status"`
 	Rating         *int          `json:"rating" db:"rating"` // Can be NULL
 	StartedAt      sql.NullTime  `json:"started_at" db:"started_at"`
 	CompletedAt    sql.NullTime  `json:"completed_at" db:"completed_at"`
 	UpdatedAt      time.Time     `json:"updated_at" db:"updated_at"`
+	RereadCount    int           `json:"reread_count" db:"reread_count"` // Times restarted after completion
+	Version        int           `json:"version" db:"version"`           // Bumped on every progress write
+	Favorite       bool          `json:"favorite" db:"favorite"`
+	Priority       int           `json:"priority" db:"priority"` // 0 (none) to 3 (high)
+	Hidden         bool          `json:"hidden" db:"hidden"`     // Kept out of the public library and activity
+}
+
+// ReadThrough is one pass through a series. Finished passes are archived when
+// the entry leaves "completed"; the entry's own pass is reported as current.
+type ReadThrough struct {
+	Number      int           `json:"number"` // 1 for the first read
+	Chapter     ChapterNumber `json:"chapter"`
+	Rating      *int          `json:"rating"`
+	StartedAt   *time.Time    `json:"started_at"`
+	CompletedAt *time.Time    `json:"completed_at"`
+	Current     bool          `json:"current"`
 }
 
 // UserProgressWithManga includes manga details with progress
 type UserProgressWithManga struct {
 	UserProgress
-	Manga Manga `json:"manga"`
+	ProgressPercent *float64 `json:"progress_percent"` // NULL when the manga's chapter count is unknown
+	Manga           Manga    `json:"manga"`
+	Shelves         []string `json:"shelves"`
+	Tags            []string `json:"tags"`
 }
 
+// ChapterSuggestion proposes a total chapter count for a manga whose count is
+// unknown, based on the furthest chapter any reader has reached
+type ChapterSuggestion struct {
+	MangaID        string        `json:"manga_id"`
+	Title          string        `json:"title"`
+	HighestChapter ChapterNumber `json:"highest_chapter"`
+	SuggestedTotal int           `json:"suggested_total_chapters"`
+	Readers        int           `json:"readers"`
+}
+
+// Library sort keys
+const (
+	LibrarySortUpdated  = "updated"
+	LibrarySortTitle    = "title"
+	LibrarySortRating   = "rating"
+	LibrarySortProgress = "progress"
+	LibrarySortPriority = "priority"
+)
+
+// LibraryQuery represents filters, sorting and paging for listing a user's library
+type LibraryQuery struct {
+	Shelf     string        `form:"shelf"` // Shelf ID or name
+	Tag       string        `form:"tag"`
+	Status    ReadingStatus `form:"status"`
+	Genre     string        `form:"genre"`
+	Q         string        `form:"q"` // Title search (case-insensitive partial match)
+	MinRating int           `form:"min_rating" binding:"omitempty,min=1,max=10"`
+	MaxRating int           `form:"max_rating" binding:"omitempty,min=1,max=10"`
+	HasUnread *bool         `form:"has_unread"` // Entries behind (or caught up with) the latest chapter
+	Favorite  *bool         `form:"favorite"`
+	Sort      string        `form:"sort" binding:"omitempty,oneof=updated title rating progress priority"`
+	Order     string        `form:"order" binding:"omitempty,oneof=asc desc"`
+	Limit     int           `form:"limit" binding:"omitempty,min=0,max=100"`
+	Offset    int           `form:"offset" binding:"omitempty,min=0"`
+
+	// ExcludeHidden leaves out hidden entries; set when someone else views the library
+	ExcludeHidden bool `form:"-"`
+	// PublicShelvesOnly limits the shelf filter and the listed shelves to public ones
+	PublicShelvesOnly bool `form:"-"`
+}
+
+// Policies for a progress update whose version is out of date
+const (
+	ConflictPolicyReject         = "reject"
+	ConflictPolicyHighestChapter = "highest_chapter"
+)
+
 // ProgressUpdateRequest represents data for updating reading progress
 type ProgressUpdateRequest struct {
-	CurrentChapter *int           `json:"current_chapter"`
+	CurrentChapter *ChapterNumber `json:"current_chapter"`
 	Status         *ReadingStatus `json:"status"`
 	Rating         *int           `json:"rating" binding:"omitempty,min=1,max=10"`
+	// Version is the progress version the update is based on; nil skips the check
+	Version *int `json:"version" binding:"omitempty,min=1"`
+	// OnConflict decides what happens when Version is out of date (default "reject")
+	OnConflict string `json:"on_conflict" binding:"omitempty,oneof=reject highest_chapter"`
 }
 
 // LibraryAddRequest represents data for adding manga to library
 type LibraryAddRequest struct {
 	MangaID        string        `json:"manga_id" binding:"required"`
 	Status         ReadingStatus `json:"status" binding:"required,oneof=reading completed plan_to_read on_hold dropped"`
-	CurrentChapter int           `json:"current_chapter"`
+	CurrentChapter ChapterNumber `json:"current_chapter"`
+	Rating         *int          `json:"rating" binding:"omitempty,min=1,max=10"`
+}
+
+// Library entry priorities
+const (
+	PriorityNone   = 0
+	PriorityLow    = 1
+	PriorityMedium = 2
+	PriorityHigh   = 3
+)
+
+// LibraryEntryUpdateRequest represents data for starring, prioritizing or hiding a library entry.
+// None of the fields are versioned, so these changes never conflict with progress updates.
+type LibraryEntryUpdateRequest struct {
+	Favorite *bool `json:"favorite"`
+	Priority *int  `json:"priority" binding:"omitempty,min=0,max=3"`
+	Hidden   *bool `json:"hidden"`
 }
 
 // ProgressSyncMessage represents TCP sync protocol message
@@ -53,7 +169,7 @@ type ProgressSyncMessage struct {
 	Type           string        `json:"type"` // "update", "request", "response"
 	UserID         string        `json:"user_id,omitempty"`
 	MangaID        string        `json:"manga_id"`
-	CurrentChapter int           `json:"current_chapter"`
+	CurrentChapter ChapterNumber `json:"current_chapter"`
 	Status         ReadingStatus `json:"status"`
 	Timestamp      time.Time     `json:"timestamp"`
 }
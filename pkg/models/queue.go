@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Up-next limits
+const (
+	DefaultUpNextLimit = 10
+	MaxUpNextLimit     = 50
+)
+
+// QueueEntry is a library entry placed in the user's manual reading queue
+type QueueEntry struct {
+	UserProgressWithManga
+	Position int       `json:"position"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+// QueueAddRequest represents a library entry being added to the reading queue
+type QueueAddRequest struct {
+	MangaID  string `json:"manga_id" binding:"required"`
+	Position *int   `json:"position" binding:"omitempty,min=0"` // Defaults to the end of the queue
+}
+
+// QueueOrderRequest lists every queued manga ID in its new order
+type QueueOrderRequest struct {
+	MangaIDs []string `json:"manga_ids" binding:"required,min=1"`
+}
+
+// UpNextQuery represents paging for the up-next list
+type UpNextQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// UpNextEntry is a library entry suggested as something to read next
+type UpNextEntry struct {
+	UserProgressWithManga
+	Rank           int      `json:"rank"`            // 1 for the first suggestion
+	Queued         bool     `json:"queued"`          // Placed by hand rather than ranked
+	UnreadChapters *float64 `json:"unread_chapters"` // NULL when the manga's chapter count is unknown
+}
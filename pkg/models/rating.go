@@ -0,0 +1,42 @@
+package models
+
+import "math"
+
+// RatingPriorWeight is the number of catalog-average ratings blended into every
+// manga's weighted score, so a handful of votes cannot outrank a well-rated series
+const RatingPriorWeight = 5
+
+// CommunityRating aggregates the ratings users have given a manga
+type CommunityRating struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	// Score is the Bayesian-weighted mean, pulled towards the catalog-wide mean
+	Score float64 `json:"score"`
+	// Histogram[i] is the number of ratings of i+1
+	Histogram [10]int `json:"histogram"`
+}
+
+// NewCommunityRating builds the aggregate for a manga from its rating histogram
+// and the mean of every rating in the catalog. It returns nil when the manga
+// has not been rated.
+func NewCommunityRating(histogram [10]int, catalogMean float64) *CommunityRating {
+	count, sum := 0, 0
+	for i, n := range histogram {
+		count += n
+		sum += n * (i + 1)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	return &CommunityRating{
+		Count:     count,
+		Mean:      roundRating(float64(sum) / float64(count)),
+		Score:     roundRating((RatingPriorWeight*catalogMean + float64(sum)) / float64(RatingPriorWeight+count)),
+		Histogram: histogram,
+	}
+}
+
+func roundRating(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Review sort keys
+const (
+	ReviewSortNewest  = "newest"
+	ReviewSortHelpful = "helpful"
+)
+
+// Review is a user's written review of a manga in their library
+type Review struct {
+	ID           string    `json:"id" db:"id"`
+	UserID       string    `json:"user_id" db:"user_id"`
+	Username     string    `json:"username"`
+	MangaID      string    `json:"manga_id" db:"manga_id"`
+	MangaTitle   string    `json:"manga_title"`
+	Body         string    `json:"body" db:"body"`
+	Spoiler      bool      `json:"spoiler" db:"spoiler"`
+	Rating       *int      `json:"rating"` // The reviewer's rating of the library entry
+	HelpfulCount int       `json:"helpful_count"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ReviewRequest represents data for writing or replacing a review
+type ReviewRequest struct {
+	Body    string `json:"body" binding:"required,max=10000"`
+	Spoiler bool   `json:"spoiler"`
+}
+
+// ReviewQuery represents sorting and paging for listing a manga's reviews
+type ReviewQuery struct {
+	Sort   string `form:"sort" binding:"omitempty,oneof=newest helpful"`
+	Limit  int    `form:"limit" binding:"omitempty,min=0,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
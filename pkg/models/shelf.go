@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// ShelfVisibility controls who can see a shelf
+type ShelfVisibility string
+
+const (
+	ShelfVisibilityPrivate ShelfVisibility = "private"
+	ShelfVisibilityPublic  ShelfVisibility = "public"
+)
+
+// Shelf is a user-defined collection of library entries
+type Shelf struct {
+	ID         string          `json:"id" db:"id"`
+	UserID     string          `json:"user_id" db:"user_id"`
+	Name       string          `json:"name" db:"name"`
+	Position   int             `json:"position" db:"position"`
+	Visibility ShelfVisibility `json:"visibility" db:"visibility"`
+	EntryCount int             `json:"entry_count"`
+	CreatedAt  time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// ShelfCreateRequest represents data for creating a shelf
+type ShelfCreateRequest struct {
+	Name       string          `json:"name" binding:"required,max=64"`
+	Visibility ShelfVisibility `json:"visibility" binding:"omitempty,oneof=private public"`
+	Position   *int            `json:"position" binding:"omitempty,min=0"`
+}
+
+// ShelfUpdateRequest represents data for renaming, moving or changing the visibility of a shelf
+type ShelfUpdateRequest struct {
+	Name       *string          `json:"name" binding:"omitempty,max=64"`
+	Visibility *ShelfVisibility `json:"visibility" binding:"omitempty,oneof=private public"`
+	Position   *int             `json:"position" binding:"omitempty,min=0"`
+}
+
+// ShelfOrderRequest lists shelf IDs in their new display order
+type ShelfOrderRequest struct {
+	ShelfIDs []string `json:"shelf_ids" binding:"required,min=1"`
+}
+
+// ShelfEntryRequest represents a library entry being placed on a shelf
+type ShelfEntryRequest struct {
+	MangaID string `json:"manga_id" binding:"required"`
+}
+
+// TagsUpdateRequest replaces the tags of a library entry
+type TagsUpdateRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// TagCount reports how many library entries carry a tag
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
@@ -0,0 +1,96 @@
+package models
+
+import "time"
+
+// DateLayout is how calendar days are written in reading timelines
+const DateLayout = "2006-01-02"
+
+// TimelineBucket is the length of each point of a reading timeline
+type TimelineBucket string
+
+const (
+	TimelineBucketDay   TimelineBucket = "day"
+	TimelineBucketWeek  TimelineBucket = "week"
+	TimelineBucketMonth TimelineBucket = "month"
+)
+
+// Window returns the start (inclusive) and end (exclusive) of the bucket
+// containing t, in UTC. Weeks start on Monday, as for reading goals.
+func (b TimelineBucket) Window(t time.Time) (time.Time, time.Time) {
+	switch b {
+	case TimelineBucketWeek:
+		return GoalPeriodWeek.Window(t)
+	case TimelineBucketMonth:
+		return GoalPeriodMonth.Window(t)
+	default:
+		t = t.UTC()
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	}
+}
+
+// TimelineQuery represents the query parameters of a reading timeline.
+// From and To are days written as YYYY-MM-DD.
+type TimelineQuery struct {
+	From   string         `form:"from"`
+	To     string         `form:"to"`
+	Bucket TimelineBucket `form:"bucket" binding:"omitempty,oneof=day week month"`
+}
+
+// TimelinePoint counts the chapters read in the bucket starting on Start
+type TimelinePoint struct {
+	Start    string `json:"start"`
+	Chapters int    `json:"chapters"`
+}
+
+// ReadingStreak counts consecutive days with reading. Current is zero unless
+// the user read today or yesterday.
+type ReadingStreak struct {
+	Current    int     `json:"current"`
+	Longest    int     `json:"longest"`
+	LastReadOn *string `json:"last_read_on"`
+}
+
+// ReadingTimeline is a user's chapters read over time
+type ReadingTimeline struct {
+	Bucket        TimelineBucket  `json:"bucket"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	Points        []TimelinePoint `json:"points"`
+	TotalChapters int             `json:"total_chapters"`
+	ActiveDays    int             `json:"active_days"`
+	Streak        ReadingStreak   `json:"streak"`
+}
+
+// CompositionGroup summarizes the library entries that share a genre, author
+// or publication status. Rates and the average rating are nil when no entry
+// they are based on exists.
+type CompositionGroup struct {
+	Name           string   `json:"name"`
+	Titles         int      `json:"titles"`
+	Started        int      `json:"started"` // Titles not in plan_to_read
+	Completed      int      `json:"completed"`
+	Dropped        int      `json:"dropped"`
+	ChaptersRead   int      `json:"chapters_read"`
+	Rated          int      `json:"rated"`
+	AverageRating  *float64 `json:"average_rating"`
+	CompletionRate *float64 `json:"completion_rate"` // Completed share of the started titles
+	DroppedRate    *float64 `json:"dropped_rate"`    // Dropped share of the started titles
+}
+
+// RatingHistogram counts a user's ratings. Counts[i] is the number of entries rated i+1.
+type RatingHistogram struct {
+	Counts        []int    `json:"counts"`
+	Rated         int      `json:"rated"`
+	AverageRating *float64 `json:"average_rating"`
+}
+
+// LibraryComposition breaks a user's library down by what is in it. Groups are
+// ordered by number of titles, most first.
+type LibraryComposition struct {
+	Titles              int                `json:"titles"`
+	Genres              []CompositionGroup `json:"genres"`
+	Authors             []CompositionGroup `json:"authors"`
+	PublicationStatuses []CompositionGroup `json:"publication_statuses"`
+	Ratings             RatingHistogram    `json:"ratings"`
+}
@@ -15,7 +15,7 @@ const (
 	TCPMessageTypeAuthSuccess TCPMessageType = "auth_success" // Authentication successful
 	TCPMessageTypeAuthFailed  TCPMessageType = "auth_failed"  // Authentication failed
 	TCPMessageTypePong        TCPMessageType = "pong"         // Heartbeat pong response
-	TCPMessageTypeBroadcast   TCPMessageType = "broadcast"    // Progress broadcast to all clients
+	TCPMessageTypeBroadcast   TCPMessageType = "broadcast"    // Progress broadcast to the clients allowed to see it
 	TCPMessageTypeError       TCPMessageType = "error"        // Error message
 )
 
@@ -45,25 +45,34 @@ type TCPAuthFailedMessage struct {
 
 // TCPProgressMessage contains progress update data
 type TCPProgressMessage struct {
+	UserID         string        `json:"user_id,omitempty"` // Set by the API server's internal bridge only
 	MangaID        string        `json:"manga_id"`
 	MangaTitle     string        `json:"manga_title,omitempty"`
 	Username       string        `json:"username,omitempty"`
-	CurrentChapter int           `json:"current_chapter"`
+	CurrentChapter ChapterNumber `json:"current_chapter"`
 	Status         ReadingStatus `json:"status,omitempty"`
 	Rating         *int          `json:"rating,omitempty"`
+	Version        int           `json:"version,omitempty"` // Progress version after the update
 }
 
-// TCPProgressBroadcast contains progress update broadcast to all clients
+// TCPProgressBroadcast contains a progress update broadcast to the clients in its audience
 type TCPProgressBroadcast struct {
 	UserID         string        `json:"user_id"`
 	Username       string        `json:"username"`
 	MangaID        string        `json:"manga_id"`
 	MangaTitle     string        `json:"manga_title"`
-	CurrentChapter int           `json:"current_chapter"`
+	CurrentChapter ChapterNumber `json:"current_chapter"`
 	Status         ReadingStatus `json:"status"`
+	Version        int           `json:"version,omitempty"` // Progress version after the update
 	Timestamp      time.Time     `json:"timestamp"`
 }
 
+// BroadcastAudience says which connected clients may receive a progress broadcast
+type BroadcastAudience struct {
+	Public  bool     // Every connected client
+	UserIDs []string // Otherwise, only the clients signed in as these users
+}
+
 // TCPErrorMessage contains error information
 type TCPErrorMessage struct {
 	Code    string `json:"code"`
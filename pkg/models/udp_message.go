@@ -28,9 +28,10 @@ type UDPMessage struct {
 
 // UDPRegisterMessage contains registration data
 type UDPRegisterMessage struct {
-	ClientID string `json:"client_id"` // Unique client identifier
-	UserID   string `json:"user_id,omitempty"`
-	Username string `json:"username,omitempty"`
+	ClientID string `json:"client_id"`          // Unique client identifier
+	Token    string `json:"token"`              // JWT; the server takes the user from its claims
+	UserID   string `json:"user_id,omitempty"`  // Informational only
+	Username string `json:"username,omitempty"` // Informational only
 }
 
 // UDPRegisterSuccessMessage contains successful registration response
@@ -51,12 +52,13 @@ type UDPUnregisterMessage struct {
 
 // UDPNotification contains chapter release notification data
 type UDPNotification struct {
-	MangaID       string    `json:"manga_id"`
-	MangaTitle    string    `json:"manga_title"`
-	ChapterNumber int       `json:"chapter_number"`
-	ChapterTitle  string    `json:"chapter_title,omitempty"`
-	ReleaseDate   time.Time `json:"release_date"`
-	Message       string    `json:"message"`
+	UserID        string        `json:"user_id,omitempty"` // Deliver only to this user's clients; empty broadcasts to all
+	MangaID       string        `json:"manga_id"`
+	MangaTitle    string        `json:"manga_title"`
+	ChapterNumber ChapterNumber `json:"chapter_number"`
+	ChapterTitle  string        `json:"chapter_title,omitempty"`
+	ReleaseDate   time.Time     `json:"release_date"`
+	Message       string        `json:"message"`
 }
 
 // UDPErrorMessage contains error information
@@ -1,15 +1,53 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// ProfileVisibility controls who can see a user's profile, library and reading activity
+type ProfileVisibility string
+
+const (
+	ProfileVisibilityPublic    ProfileVisibility = "public"
+	ProfileVisibilityFollowers ProfileVisibility = "followers"
+	ProfileVisibilityPrivate   ProfileVisibility = "private"
+)
+
+// UserRole grants permissions beyond a user's own content
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin" // May moderate content created by other users
+)
 
 // User represents a registered user in the system
 type User struct {
-	ID           string    `json:"id" db:"id"`
-	Username     string    `json:"username" db:"username"`
-	Email        string    `json:"email" db:"email"`
-	PasswordHash string    `json:"-" db:"password_hash"` // Never expose password hash in JSON
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID           string            `json:"id" db:"id"`
+	Username     string            `json:"username" db:"username"`
+	Email        string            `json:"email" db:"email"`
+	PasswordHash string            `json:"-" db:"password_hash"` // Never expose password hash in JSON
+	Visibility   ProfileVisibility `json:"visibility" db:"visibility"`
+	Role         UserRole          `json:"role" db:"role"`
+	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// IsAdmin reports whether the user may moderate other users' content
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
+// reservedUsernames are path segments under /users that would hide a profile of the same name
+var reservedUsernames = map[string]bool{
+	"me": true, "library": true, "progress": true, "stats": true, "shelves": true,
+	"tags": true, "goals": true, "reviews": true, "queue": true, "feed": true, "achievements": true,
+}
+
+// IsReservedUsername reports whether a username cannot be registered
+func IsReservedUsername(username string) bool {
+	return reservedUsernames[strings.ToLower(username)]
 }
 
 // UserLoginRequest represents login credentials
@@ -25,20 +63,29 @@ type UserRegisterRequest struct {
 	Password string `json:"password" binding:"required,min=6"`
 }
 
+// PrivacyUpdateRequest represents a change to a user's privacy settings
+type PrivacyUpdateRequest struct {
+	Visibility ProfileVisibility `json:"visibility" binding:"required,oneof=public followers private"`
+}
+
 // UserResponse represents user data returned to clients (without sensitive fields)
 type UserResponse struct {
-	ID        string    `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         string            `json:"id"`
+	Username   string            `json:"username"`
+	Email      string            `json:"email"`
+	Visibility ProfileVisibility `json:"visibility"`
+	Role       UserRole          `json:"role"`
+	CreatedAt  time.Time         `json:"created_at"`
 }
 
 // ToResponse converts User to UserResponse
 func (u *User) ToResponse() UserResponse {
 	return UserResponse{
-		ID:        u.ID,
-		Username:  u.Username,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
+		ID:         u.ID,
+		Username:   u.Username,
+		Email:      u.Email,
+		Visibility: u.Visibility,
+		Role:       u.Role,
+		CreatedAt:  u.CreatedAt,
 	}
 }
@@ -64,6 +64,19 @@ func Error(c *gin.Context, statusCode int, code string, message string) {
 	})
 }
 
+// ErrorWithData sends an error response that also carries data, such as the
+// current state of a resource the request conflicted with
+func ErrorWithData(c *gin.Context, statusCode int, code string, message string, data interface{}) {
+	c.JSON(statusCode, APIResponse{
+		Success: false,
+		Data:    data,
+		Error: &APIError{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
 // Common error responses
 
 // BadRequest sends a 400 Bad Request response
@@ -92,7 +92,11 @@ func seedMangaFromJSON(db *sql.DB) error {
 		return fmt.Errorf("failed to read data file: %w", err)
 	}
 
-	var mangaList []models.Manga
+	// Entries may list alternative titles used to match imported libraries
+	var mangaList []struct {
+		models.Manga
+		Aliases []string `json:"aliases"`
+	}
 	if err := json.Unmarshal(content, &mangaList); err != nil {
 		return fmt.Errorf("failed to unmarshal manga list: %w", err)
 	}
@@ -117,6 +121,12 @@ func seedMangaFromJSON(db *sql.DB) error {
 			log.Printf("Failed to insert manga %s: %v", manga.Title, err)
 			continue
 		}
+
+		for _, alias := range manga.Aliases {
+			if _, err := db.Exec(`INSERT OR IGNORE INTO manga_aliases (manga_id, alias) VALUES (?, ?)`, manga.ID, alias); err != nil {
+				log.Printf("Failed to insert alias %s for %s: %v", alias, manga.Title, err)
+			}
+		}
 		count++
 	}
 
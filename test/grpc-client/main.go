@@ -213,7 +213,7 @@ func handleUpdateProgress(args []string) {
 	userID := args[0]
 	mangaID := args[1]
 
-	chapter, err := strconv.ParseInt(args[2], 10, 32)
+	chapter, err := strconv.ParseFloat(args[2], 64)
 	if err != nil {
 		fmt.Printf("Invalid chapter number: %s\n", args[2])
 		return
@@ -222,7 +222,7 @@ func handleUpdateProgress(args []string) {
 	req := &pb.UpdateProgressRequest{
 		UserId:  userID,
 		MangaId: mangaID,
-		Chapter: int32(chapter),
+		Chapter: &chapter,
 	}
 
 	// Optional rating
@@ -252,7 +252,7 @@ func handleUpdateProgress(args []string) {
 	fmt.Println("✅ Progress updated:")
 	fmt.Printf("   User ID: %s\n", resp.Progress.UserId)
 	fmt.Printf("   Manga ID: %s\n", resp.Progress.MangaId)
-	fmt.Printf("   Current Chapter: %d\n", resp.Progress.CurrentChapter)
+	fmt.Printf("   Current Chapter: %g\n", resp.Progress.CurrentChapter)
 	fmt.Printf("   Status: %s\n", resp.Progress.Status)
 	if resp.Progress.Rating > 0 {
 		fmt.Printf("   Rating: %d/10\n", resp.Progress.Rating)
@@ -189,11 +189,12 @@ func testSearchWithPagination(ctx context.Context, client pb.MangaServiceClient)
 
 func testUpdateProgress(ctx context.Context, client pb.MangaServiceClient) {
 	// Note: You'll need actual user and manga IDs
+	chapter := 50.0
 	req := &pb.UpdateProgressRequest{
 		UserId:  "test-user-id", // Change to actual user ID
 		MangaId: "1",            // Change to actual manga ID
 		Status:  "reading",
-		Chapter: 50,
+		Chapter: &chapter,
 		Rating:  8,
 	}
 
@@ -208,7 +209,7 @@ func testUpdateProgress(ctx context.Context, client pb.MangaServiceClient) {
 	fmt.Printf("   ✓ Progress updated successfully\n")
 	fmt.Printf("   User ID: %s\n", resp.Progress.UserId)
 	fmt.Printf("   Manga ID: %s\n", resp.Progress.MangaId)
-	fmt.Printf("   Current Chapter: %d\n", resp.Progress.CurrentChapter)
+	fmt.Printf("   Current Chapter: %g\n", resp.Progress.CurrentChapter)
 	fmt.Printf("   Status: %s\n", resp.Progress.Status)
 	fmt.Printf("   Rating: %d/10\n", resp.Progress.Rating)
 }
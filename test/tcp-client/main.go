@@ -128,8 +128,11 @@ func main() {
 				continue
 			}
 
-			var chapter int
-			fmt.Sscanf(arg2, "%d", &chapter)
+			chapter, err := models.ParseChapterNumber(arg2)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
 
 			progressMsg := models.TCPMessage{
 				Type:      models.TCPMessageTypeProgress,
@@ -144,7 +147,7 @@ func main() {
 			if err := sendMessage(writer, progressMsg); err != nil {
 				log.Printf("Failed to send progress: %v", err)
 			} else {
-				log.Printf("Sent progress update: manga=%s, chapter=%d", arg1, chapter)
+				log.Printf("Sent progress update: manga=%s, chapter=%s", arg1, chapter)
 			}
 
 		default:
@@ -195,7 +198,7 @@ func listenForMessages(reader *bufio.Reader) {
 			dataBytes, _ := json.Marshal(msg.Data)
 			var broadcast models.TCPProgressBroadcast
 			json.Unmarshal(dataBytes, &broadcast)
-			fmt.Printf("\n📢 Progress Update: %s read %s chapter %d (%s) at %s\n",
+			fmt.Printf("\n📢 Progress Update: %s read %s chapter %s (%s) at %s\n",
 				broadcast.Username,
 				broadcast.MangaTitle,
 				broadcast.CurrentChapter,
@@ -130,7 +130,7 @@ func main() {
 				dataBytes, _ := json.Marshal(broadcastMsg.Data)
 				var broadcast models.TCPProgressBroadcast
 				json.Unmarshal(dataBytes, &broadcast)
-				log.Printf("  User: %s, Manga: %s, Chapter: %d", broadcast.Username, broadcast.MangaID, broadcast.CurrentChapter)
+				log.Printf("  User: %s, Manga: %s, Chapter: %s", broadcast.Username, broadcast.MangaID, broadcast.CurrentChapter)
 			}
 		}
 	}
@@ -12,14 +12,15 @@ import (
 )
 
 func main() {
-	// Get optional client ID from command line
-	clientID := "test-client-1"
-	username := "test-user"
-	if len(os.Args) >= 2 {
-		clientID = os.Args[1]
+	if len(os.Args) < 2 {
+		log.Fatal("Usage: go run test/udp-simple/main.go <JWT_TOKEN> [CLIENT_ID]")
 	}
+
+	// The server registers the client for the user named by the token
+	token := os.Args[1]
+	clientID := "test-client-1"
 	if len(os.Args) >= 3 {
-		username = os.Args[2]
+		clientID = os.Args[2]
 	}
 
 	// Connect to UDP server
@@ -46,8 +47,7 @@ func main() {
 		Timestamp: time.Now(),
 		Data: models.UDPRegisterMessage{
 			ClientID: clientID,
-			Username: username,
-			UserID:   "user-123",
+			Token:    token,
 		},
 	}
 
@@ -76,7 +76,10 @@ func main() {
 		json.Unmarshal(dataBytes, &successData)
 		log.Printf("Registered as: %s", successData.ClientID)
 	} else if registerResp.Type == models.UDPMessageTypeRegisterFailed {
-		log.Fatalf("❌ Registration failed")
+		dataBytes, _ := json.Marshal(registerResp.Data)
+		var failedData models.UDPRegisterFailedMessage
+		json.Unmarshal(dataBytes, &failedData)
+		log.Fatalf("❌ Registration failed: %s", failedData.Reason)
 	}
 
 	// Send a ping
@@ -134,7 +137,7 @@ func main() {
 			json.Unmarshal(dataBytes, &notification)
 			log.Printf("\n📬 Notification #%d received:", notificationCount)
 			log.Printf("  Manga: %s (ID: %s)", notification.MangaTitle, notification.MangaID)
-			log.Printf("  Chapter: %d - %s", notification.ChapterNumber, notification.ChapterTitle)
+			log.Printf("  Chapter: %s - %s", notification.ChapterNumber, notification.ChapterTitle)
 			log.Printf("  Message: %s", notification.Message)
 			log.Printf("  Released: %s", notification.ReleaseDate.Format(time.RFC3339))
 		}
@@ -0,0 +1,166 @@
+package unit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/achievement"
+	grpchandler "github.com/tnphucccc/mangahub/internal/grpc"
+	"github.com/tnphucccc/mangahub/internal/grpc/pb"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestEvaluateAchievements(t *testing.T) {
+	metrics := models.AchievementMetrics{
+		models.AchievementMetricCompletedSeries: 1,
+		models.AchievementMetricChaptersRead:    250,
+		models.AchievementMetricWeeklyChapters:  99,
+		models.AchievementMetricGenresRead:      5,
+	}
+	unlocked := map[string]time.Time{"hundred_chapters": time.Now()}
+
+	reached := map[string]bool{}
+	for _, a := range achievement.Evaluate(metrics, unlocked) {
+		reached[a.ID] = true
+	}
+
+	for _, id := range []string{"first_completion", "five_genres"} {
+		if !reached[id] {
+			t.Errorf("expected %s to be reached", id)
+		}
+	}
+	for _, id := range []string{"hundred_chapters", "thousand_chapters", "binge_week", "ten_ratings"} {
+		if reached[id] {
+			t.Errorf("expected %s not to be reached again or early", id)
+		}
+	}
+}
+
+func TestAchievementProgress(t *testing.T) {
+	unlockedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	metrics := models.AchievementMetrics{
+		models.AchievementMetricCompletedSeries: 25,
+		models.AchievementMetricRatedSeries:     3,
+	}
+
+	progress := achievement.Progress(metrics, map[string]time.Time{"thousand_chapters": unlockedAt})
+	if len(progress) != len(achievement.Catalog) {
+		t.Fatalf("expected every achievement, got %d", len(progress))
+	}
+
+	byID := map[string]models.AchievementProgress{}
+	for _, p := range progress {
+		byID[p.ID] = p
+	}
+
+	if p := byID["ten_completions"]; p.Current != 10 || p.Unlocked {
+		t.Errorf("expected progress capped at the target and not yet unlocked, got %+v", p)
+	}
+	if p := byID["ten_ratings"]; p.Current != 3 {
+		t.Errorf("expected 3 of 10 ratings, got %d", p.Current)
+	}
+	if p := byID["thousand_chapters"]; !p.Unlocked || p.Current != p.Target || p.UnlockedAt == nil || !p.UnlockedAt.Equal(unlockedAt) {
+		t.Errorf("expected an unlocked achievement to stay complete, got %+v", p)
+	}
+}
+
+// newAchievementServices wires achievement checks into progress updates the way the servers do
+func newAchievementServices(db *sql.DB) (*manga.Service, *achievement.Service) {
+	userRepo := user.NewRepository(db)
+	mangaService := manga.NewService(manga.NewRepository(db), userRepo)
+	achievementService := achievement.NewService(achievement.NewRepository(db), user.NewService(userRepo, nil))
+	mangaService.OnProgressUpdate(achievementService.CheckAchievements)
+	return mangaService, achievementService
+}
+
+func TestAchievementMetrics(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "manga-001", "Oda", 10, "Action", "Comedy")
+	seedManga(t, db, "manga-002", "Miura", 20, "action", "Drama")
+	seedManga(t, db, "manga-003", "Ito", 0, "Horror")
+	mangaService, _ := newAchievementServices(db)
+
+	chapter := func(n float64) *models.ChapterNumber { c := models.ChapterNumber(n); return &c }
+	rating := func(n int) *int { return &n }
+	completed := models.ReadingStatusCompleted
+
+	for _, id := range []string{"manga-001", "manga-002"} {
+		if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add %s: %v", id, err)
+		}
+	}
+	if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: "manga-003", Status: models.ReadingStatusPlanToRead}); err != nil {
+		t.Fatalf("Failed to add manga-003: %v", err)
+	}
+	updates := map[string]models.ProgressUpdateRequest{
+		"manga-001": {CurrentChapter: chapter(10), Status: &completed, Rating: rating(8)},
+		"manga-002": {CurrentChapter: chapter(5), Rating: rating(6)},
+	}
+	for id, req := range updates {
+		if err := mangaService.UpdateProgress(alice.ID, id, req); err != nil {
+			t.Fatalf("Failed to update %s: %v", id, err)
+		}
+	}
+
+	metrics, err := achievement.NewRepository(db).Metrics(alice.ID, time.Now().Add(-7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to measure metrics: %v", err)
+	}
+
+	// Genres of the planned series do not count, and genres match case-insensitively
+	expected := models.AchievementMetrics{
+		models.AchievementMetricCompletedSeries: 1,
+		models.AchievementMetricChaptersRead:    15,
+		models.AchievementMetricRatedSeries:     2,
+		models.AchievementMetricWeeklyChapters:  15,
+		models.AchievementMetricGenresRead:      3,
+	}
+	for metric, want := range expected {
+		if metrics[metric] != want {
+			t.Errorf("%s = %d, expected %d", metric, metrics[metric], want)
+		}
+	}
+
+	t.Logf("✓ Achievement metrics measured from the library")
+}
+
+func TestGRPCUpdateProgress_UnlocksAchievement(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "manga-001", "Oda", 10, "Action")
+	mangaService, achievementService := newAchievementServices(db)
+	userService := user.NewService(user.NewRepository(db), nil)
+	server := grpchandler.NewServer(mangaService, stats.NewService(stats.NewRepository(db)), userService, profile.NewService(userService, mangaService))
+
+	if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+		t.Fatalf("Failed to add to library: %v", err)
+	}
+
+	chapter := 10.0
+	_, err := server.UpdateProgress(context.Background(), &pb.UpdateProgressRequest{
+		UserId:  alice.ID,
+		MangaId: "manga-001",
+		Status:  string(models.ReadingStatusCompleted),
+		Chapter: &chapter,
+	})
+	if err != nil {
+		t.Fatalf("UpdateProgress failed: %v", err)
+	}
+
+	unlocked, err := achievementService.Unlocked(alice.ID)
+	if err != nil {
+		t.Fatalf("Failed to list unlocked achievements: %v", err)
+	}
+	if len(unlocked) != 1 || unlocked[0].ID != "first_completion" {
+		t.Errorf("Expected first_completion to be unlocked over gRPC, got %+v", unlocked)
+	}
+
+	t.Logf("✓ Progress updated over gRPC unlocks achievements")
+}
@@ -0,0 +1,83 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/activity"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func activityProgress(chapter float64, status models.ReadingStatus, rating *int) models.UserProgress {
+	return models.UserProgress{
+		UserID:         "user-1",
+		MangaID:        "manga-1",
+		CurrentChapter: models.ChapterNumber(chapter),
+		Status:         status,
+		Rating:         rating,
+	}
+}
+
+func TestProgressEvents(t *testing.T) {
+	seven, nine := 7, 9
+
+	tests := []struct {
+		name   string
+		before models.UserProgress
+		after  models.UserProgress
+		want   []models.ActivityType
+	}{
+		{
+			name:   "chapter forward",
+			before: activityProgress(10, models.ReadingStatusReading, nil),
+			after:  activityProgress(12, models.ReadingStatusReading, nil),
+			want:   []models.ActivityType{models.ActivityProgress},
+		},
+		{
+			name:   "chapter back",
+			before: activityProgress(12, models.ReadingStatusReading, nil),
+			after:  activityProgress(3, models.ReadingStatusReading, nil),
+			want:   nil,
+		},
+		{
+			name:   "completed replaces progress",
+			before: activityProgress(90, models.ReadingStatusReading, nil),
+			after:  activityProgress(100, models.ReadingStatusCompleted, nil),
+			want:   []models.ActivityType{models.ActivityCompleted},
+		},
+		{
+			name:   "rating set",
+			before: activityProgress(5, models.ReadingStatusReading, nil),
+			after:  activityProgress(5, models.ReadingStatusReading, &seven),
+			want:   []models.ActivityType{models.ActivityRated},
+		},
+		{
+			name:   "rating unchanged",
+			before: activityProgress(5, models.ReadingStatusReading, &seven),
+			after:  activityProgress(6, models.ReadingStatusReading, &seven),
+			want:   []models.ActivityType{models.ActivityProgress},
+		},
+		{
+			name:   "finished and rated",
+			before: activityProgress(90, models.ReadingStatusReading, &seven),
+			after:  activityProgress(100, models.ReadingStatusCompleted, &nine),
+			want:   []models.ActivityType{models.ActivityCompleted, models.ActivityRated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := activity.ProgressEvents(tt.before, tt.after)
+			if len(events) != len(tt.want) {
+				t.Fatalf("Expected %d events, got %d: %+v", len(tt.want), len(events), events)
+			}
+			for i, event := range events {
+				if event.Type != tt.want[i] {
+					t.Errorf("Event %d: expected %s, got %s", i, tt.want[i], event.Type)
+				}
+				if event.UserID != "user-1" || event.MangaID != "manga-1" {
+					t.Errorf("Event %d: unexpected user or manga: %+v", i, event)
+				}
+			}
+		})
+	}
+}
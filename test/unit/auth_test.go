@@ -133,6 +133,34 @@ func TestJWT_RefreshToken(t *testing.T) {
 	t.Logf("✓ Token refreshed successfully")
 }
 
+func TestJWT_ServiceToken(t *testing.T) {
+	jwtManager := auth.NewJWTManager("test-secret-key", 7)
+
+	token, err := jwtManager.GenerateServiceToken("progress-bridge")
+	if err != nil {
+		t.Fatalf("Failed to generate service token: %v", err)
+	}
+
+	claims, err := jwtManager.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("Failed to validate service token: %v", err)
+	}
+	if !claims.Service || claims.UserID != "" || claims.Username != "progress-bridge" {
+		t.Errorf("Expected service claims for progress-bridge, got %+v", claims)
+	}
+
+	if _, err := jwtManager.RefreshToken(token); err == nil {
+		t.Error("Expected a service token not to be refreshable")
+	}
+
+	userToken, _ := jwtManager.GenerateToken(&models.User{ID: "test-user-123", Username: "testuser"})
+	if userClaims, _ := jwtManager.ValidateToken(userToken); userClaims.Service {
+		t.Error("Expected a user token not to be a service token")
+	}
+
+	t.Logf("✓ Service tokens issued and kept apart from user tokens")
+}
+
 // ==========================================
 // Password Tests
 // ==========================================
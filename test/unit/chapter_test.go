@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestParseChapterNumber(t *testing.T) {
+	valid := map[string]models.ChapterNumber{
+		"0":     0,
+		"12":    12,
+		"10.5":  10.5,
+		"99.25": 99.25,
+	}
+	for input, expected := range valid {
+		got, err := models.ParseChapterNumber(input)
+		if err != nil {
+			t.Errorf("ParseChapterNumber(%q) returned error: %v", input, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("ParseChapterNumber(%q) = %s, expected %s", input, got, expected)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-1", "10.125", "NaN", "Inf"} {
+		if _, err := models.ParseChapterNumber(input); err == nil {
+			t.Errorf("ParseChapterNumber(%q) should fail", input)
+		}
+	}
+
+	t.Logf("✓ Chapter numbers parsed and validated")
+}
+
+func TestChapterNumber_JSON(t *testing.T) {
+	var req models.ProgressUpdateRequest
+	if err := json.Unmarshal([]byte(`{"current_chapter": 10.5}`), &req); err != nil {
+		t.Fatalf("Failed to unmarshal number: %v", err)
+	}
+	if req.CurrentChapter == nil || *req.CurrentChapter != 10.5 {
+		t.Errorf("Expected chapter 10.5, got %v", req.CurrentChapter)
+	}
+
+	if err := json.Unmarshal([]byte(`{"current_chapter": "7.5"}`), &req); err != nil {
+		t.Fatalf("Failed to unmarshal string: %v", err)
+	}
+	if *req.CurrentChapter != 7.5 {
+		t.Errorf("Expected chapter 7.5, got %s", *req.CurrentChapter)
+	}
+
+	data, err := json.Marshal(models.UserProgress{CurrentChapter: 12})
+	if err != nil {
+		t.Fatalf("Failed to marshal progress: %v", err)
+	}
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	if decoded["current_chapter"] != 12.0 {
+		t.Errorf("Expected current_chapter 12, got %v", decoded["current_chapter"])
+	}
+
+	t.Logf("✓ Chapter numbers round-trip through JSON")
+}
+
+func TestChapterNumber_Bounds(t *testing.T) {
+	tests := []struct {
+		chapter models.ChapterNumber
+		total   int
+		exceeds bool
+		reaches bool
+	}{
+		{9.5, 10, false, false},
+		{10, 10, false, true},
+		{10.5, 10, true, true},
+		{500, 0, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.chapter.Exceeds(tt.total); got != tt.exceeds {
+			t.Errorf("%s.Exceeds(%d) = %v, expected %v", tt.chapter, tt.total, got, tt.exceeds)
+		}
+		if got := tt.chapter.Reaches(tt.total); got != tt.reaches {
+			t.Errorf("%s.Reaches(%d) = %v, expected %v", tt.chapter, tt.total, got, tt.reaches)
+		}
+	}
+
+	if percent := models.ChapterNumber(120).PercentOf(0); percent != nil {
+		t.Errorf("Expected unknown percent for unknown total, got %v", *percent)
+	}
+	if percent := models.ChapterNumber(1).PercentOf(3); percent == nil || *percent != 33.3 {
+		t.Errorf("Expected 33.3 percent, got %v", percent)
+	}
+
+	if whole := models.ChapterNumber(10.5).Whole(); whole != 10 {
+		t.Errorf("Expected 10 whole chapters, got %d", whole)
+	}
+
+	t.Logf("✓ Chapter bounds checked against series totals")
+}
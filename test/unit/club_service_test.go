@@ -0,0 +1,117 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/auth"
+	"github.com/tnphucccc/mangahub/internal/club"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestClubSchedule(t *testing.T) {
+	start := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	c := &models.Club{StartChapter: 10, ChaptersPerWeek: 5, ScheduleStart: start}
+
+	tests := []struct {
+		at   time.Time
+		week int
+	}{
+		{start.Add(-time.Hour), 0},
+		{start, 1},
+		{start.Add(6 * 24 * time.Hour), 1},
+		{start.Add(7 * 24 * time.Hour), 2},
+		{start.Add(30 * 24 * time.Hour), 5},
+	}
+	for _, tt := range tests {
+		if week := c.ScheduleWeek(tt.at); week != tt.week {
+			t.Errorf("ScheduleWeek(%v) = %d, want %d", tt.at, week, tt.week)
+		}
+	}
+
+	if target := c.TargetChapter(2, 0); target != 20 {
+		t.Errorf("expected week 2 target of chapter 20, got %v", target)
+	}
+	if target := c.TargetChapter(10, 42); target != 42 {
+		t.Errorf("expected the target to stop at the last chapter, got %v", target)
+	}
+}
+
+func TestClubScheduleStatus(t *testing.T) {
+	chapter := func(n float64) *models.ChapterNumber {
+		c := models.ChapterNumber(n)
+		return &c
+	}
+
+	tests := []struct {
+		name    string
+		chapter *models.ChapterNumber
+		want    string
+	}{
+		{"not in library", nil, models.ClubMemberNotStarted},
+		{"below due", chapter(12), models.ClubMemberBehind},
+		{"at due", chapter(15), models.ClubMemberOnTrack},
+		{"at target", chapter(20), models.ClubMemberAhead},
+	}
+	for _, tt := range tests {
+		if got := club.ScheduleStatus(tt.chapter, 15, 20); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestClubRemind_OnlyReachesTheMembersOwnClients(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	seedManga(t, db, "manga-001", "Oda", 100, "Action")
+
+	mangaService := newMangaService(db)
+	userService := user.NewService(user.NewRepository(db), nil)
+	clubService := club.NewService(club.NewRepository(db), userService, mangaService, profile.NewService(userService, mangaService))
+
+	mangaID := "manga-001"
+	created, err := clubService.Create(alice.ID, models.ClubCreateRequest{Name: "Pirates", MangaID: &mangaID, ChaptersPerWeek: 10})
+	if err != nil {
+		t.Fatalf("failed to create club: %v", err)
+	}
+	if sent, err := clubService.Remind(alice.ID, created.Club.ID); err != nil || sent != 1 {
+		t.Fatalf("expected one reminder for alice, got %d (%v)", sent, err)
+	}
+	reminder := <-mangaService.UDPNotificationChan
+	if reminder.UserID != alice.ID {
+		t.Fatalf("expected the reminder to be addressed to alice, got %+v", reminder)
+	}
+
+	// Registrations claiming alice's user ID receive her reminder only with her token
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server, addr := startUDPServer(t, jwtManager)
+	aliceToken, _ := jwtManager.GenerateToken(alice)
+	bobToken, _ := jwtManager.GenerateToken(bob)
+
+	aliceConn, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "alice-cli", Token: aliceToken})
+	if reply != models.UDPMessageTypeRegisterSuccess {
+		t.Fatalf("expected alice to register, got %s", reply)
+	}
+	anonymousConn, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "anonymous", UserID: alice.ID})
+	if reply != models.UDPMessageTypeRegisterFailed {
+		t.Errorf("expected a registration without a token to fail, got %s", reply)
+	}
+	bobConn, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "bob-cli", Token: bobToken, UserID: alice.ID})
+	if reply != models.UDPMessageTypeRegisterSuccess {
+		t.Fatalf("expected bob to register, got %s", reply)
+	}
+
+	server.BroadcastNotification(reminder)
+	if !receivesNotification(aliceConn) {
+		t.Error("expected alice's client to receive her reminder")
+	}
+	if receivesNotification(anonymousConn) {
+		t.Error("expected an unauthenticated registration not to receive alice's reminder")
+	}
+	if receivesNotification(bobConn) {
+		t.Error("expected bob's registration claiming alice's user ID not to receive her reminder")
+	}
+}
@@ -0,0 +1,81 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/comment"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func chapterComment(id string, parentID *string, userID string, deleted bool) models.ChapterComment {
+	return models.ChapterComment{
+		ID:       id,
+		ParentID: parentID,
+		UserID:   userID,
+		Username: userID,
+		Body:     "body of " + id,
+		Spoiler:  true,
+		Deleted:  deleted,
+	}
+}
+
+func TestBuildThreads(t *testing.T) {
+	a, b, c := "a", "b", "c"
+	roots := []models.ChapterComment{
+		chapterComment(a, nil, "alice", true),
+		chapterComment("d", nil, "bob", false),
+	}
+	replies := []models.ChapterComment{
+		chapterComment(b, &a, "bob", false),
+		chapterComment(c, &b, "alice", false),
+		chapterComment("e", &a, "carol", false),
+	}
+
+	threads := comment.BuildThreads(roots, replies)
+	if len(threads) != 2 {
+		t.Fatalf("expected 2 threads, got %d", len(threads))
+	}
+
+	first := threads[0]
+	if first.ReplyCount != 3 || len(first.Replies) != 2 {
+		t.Fatalf("expected 3 replies with 2 direct, got %d with %d direct", first.ReplyCount, len(first.Replies))
+	}
+	if first.Replies[0].ID != "b" || first.Replies[1].ID != "e" {
+		t.Errorf("expected replies in given order, got %s, %s", first.Replies[0].ID, first.Replies[1].ID)
+	}
+	if first.Replies[0].ReplyCount != 1 || first.Replies[0].Replies[0].ID != "c" {
+		t.Errorf("expected c nested under b, got %+v", first.Replies[0].Replies)
+	}
+	if first.Body != "" || first.UserID != "" || first.Username != "" || first.Spoiler {
+		t.Errorf("expected deleted comment to be redacted, got %+v", first)
+	}
+	if first.Replies[0].Body == "" {
+		t.Error("expected replies of a deleted comment to keep their body")
+	}
+
+	if threads[1].Replies == nil || threads[1].ReplyCount != 0 {
+		t.Errorf("expected an empty reply list, got %+v", threads[1].Replies)
+	}
+}
+
+func TestCanModifyComment(t *testing.T) {
+	post := chapterComment("a", nil, "author", false)
+
+	tests := []struct {
+		name string
+		user models.User
+		want bool
+	}{
+		{"author", models.User{ID: "author", Role: models.UserRoleUser}, true},
+		{"other user", models.User{ID: "other", Role: models.UserRoleUser}, false},
+		{"admin", models.User{ID: "admin", Role: models.UserRoleAdmin}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := comment.CanModify(&tt.user, &post); got != tt.want {
+				t.Errorf("CanModify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/compatibility"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func compatibilityEntry(mangaID string, status models.ReadingStatus, rating int, genres ...string) models.UserProgressWithManga {
+	entry := models.UserProgressWithManga{
+		UserProgress: models.UserProgress{MangaID: mangaID, Status: status},
+		Manga:        models.Manga{ID: mangaID, Title: mangaID, Genres: genres},
+	}
+	if rating > 0 {
+		entry.Rating = &rating
+	}
+	return entry
+}
+
+func TestCompareLibraries(t *testing.T) {
+	mine := []models.UserProgressWithManga{
+		compatibilityEntry("a", models.ReadingStatusCompleted, 9, "action"),
+		compatibilityEntry("b", models.ReadingStatusReading, 7, "action"),
+		compatibilityEntry("c", models.ReadingStatusReading, 2, "romance"),
+		compatibilityEntry("d", models.ReadingStatusCompleted, 8, "romance"),
+		compatibilityEntry("mine-only", models.ReadingStatusPlanToRead, 0, "horror"),
+	}
+	theirs := []models.UserProgressWithManga{
+		compatibilityEntry("a", models.ReadingStatusCompleted, 10, "action"),
+		compatibilityEntry("b", models.ReadingStatusReading, 6, "action"),
+		compatibilityEntry("c", models.ReadingStatusReading, 3, "romance"),
+		compatibilityEntry("d", models.ReadingStatusReading, 2, "romance"),
+		compatibilityEntry("theirs-only", models.ReadingStatusCompleted, 0, "action"),
+	}
+
+	result := compatibility.CompareLibraries(mine, theirs)
+
+	if result.SharedTitles != 4 || result.RatedInCommon != 4 {
+		t.Errorf("expected 4 shared and rated titles, got %d and %d", result.SharedTitles, result.RatedInCommon)
+	}
+	if result.SharedCompletions != 1 || result.CompletionOverlap == nil || *result.CompletionOverlap != 0.33 {
+		t.Errorf("expected 1 shared completion of 3, got %d (%v)", result.SharedCompletions, result.CompletionOverlap)
+	}
+	if result.RatingCorrelation == nil || *result.RatingCorrelation <= 0 {
+		t.Errorf("expected a positive rating correlation, got %v", result.RatingCorrelation)
+	}
+	if result.GenreOverlap == nil || *result.GenreOverlap <= 0.9 {
+		t.Errorf("expected plan-to-read genres to be ignored, got %v", result.GenreOverlap)
+	}
+	if result.Score == nil || *result.Score < 0 || *result.Score > 100 {
+		t.Fatalf("expected a score between 0 and 100, got %v", result.Score)
+	}
+
+	if len(result.Agreements) != 3 || result.Agreements[0].MangaID != "a" {
+		t.Errorf("expected a, b and c as agreements best rated first, got %+v", result.Agreements)
+	}
+	if len(result.Disagreements) != 1 || result.Disagreements[0].MangaID != "d" {
+		t.Errorf("expected d as the only disagreement, got %+v", result.Disagreements)
+	}
+}
+
+func TestCompareLibrariesNothingInCommon(t *testing.T) {
+	result := compatibility.CompareLibraries(nil, []models.UserProgressWithManga{
+		compatibilityEntry("a", models.ReadingStatusPlanToRead, 0, "action"),
+	})
+
+	if result.Score != nil || result.RatingCorrelation != nil || result.GenreOverlap != nil || result.CompletionOverlap != nil {
+		t.Errorf("expected no score or components, got %+v", result)
+	}
+	if result.Agreements == nil || result.Disagreements == nil {
+		t.Error("expected empty, non-nil title lists")
+	}
+}
+
+func TestCompatibilityService_Compare(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	seedManga(t, db, "manga-001", "Oda", 10, "Action")
+	seedManga(t, db, "manga-002", "Togashi", 10, "Action")
+	seedManga(t, db, "manga-003", "Yazawa", 10, "Romance")
+	seedManga(t, db, "manga-004", "Araki", 10, "Action")
+
+	mangaService := newMangaService(db)
+	userService := user.NewService(user.NewRepository(db), nil)
+	service := compatibility.NewService(userService, mangaService, profile.NewService(userService, mangaService))
+
+	ratings := map[string]map[string]int{
+		alice.ID: {"manga-001": 9, "manga-002": 7, "manga-003": 2, "manga-004": 4},
+		bob.ID:   {"manga-001": 10, "manga-002": 6, "manga-003": 9, "manga-004": 3},
+	}
+	for userID, rated := range ratings {
+		for mangaID, rating := range rated {
+			if err := mangaService.AddToLibrary(userID, models.LibraryAddRequest{MangaID: mangaID, Status: models.ReadingStatusReading}); err != nil {
+				t.Fatalf("Failed to add to library: %v", err)
+			}
+			rating := rating
+			req := models.ProgressUpdateRequest{Rating: &rating}
+			if mangaID == "manga-001" {
+				req.CurrentChapter = chapterPtr(10)
+			}
+			if err := mangaService.UpdateProgress(userID, mangaID, req); err != nil {
+				t.Fatalf("Failed to rate: %v", err)
+			}
+		}
+	}
+
+	// Bob's hidden entry, where they disagree most, is left out
+	hidden := true
+	if _, err := mangaService.UpdateEntry(bob.ID, "manga-003", models.LibraryEntryUpdateRequest{Hidden: &hidden}); err != nil {
+		t.Fatalf("Failed to hide entry: %v", err)
+	}
+
+	result, err := service.Compare(alice, "bob")
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+	if result.Username != "bob" || result.SharedTitles != 3 || result.RatedInCommon != 3 || result.SharedCompletions != 1 {
+		t.Errorf("Expected 3 shared and rated titles and 1 shared completion, got %+v", result)
+	}
+	if result.RatingCorrelation == nil || *result.RatingCorrelation <= 0 || result.Score == nil {
+		t.Errorf("Expected a positive correlation and a score, got %+v", result)
+	}
+	for _, title := range append(result.Agreements, result.Disagreements...) {
+		if title.MangaID == "manga-003" {
+			t.Errorf("Expected the hidden entry to be left out, got %+v", title)
+		}
+	}
+
+	if _, err := service.Compare(alice, "alice"); err == nil {
+		t.Error("Expected comparing with yourself to fail")
+	}
+	if _, err := service.Compare(alice, "nobody"); err == nil {
+		t.Error("Expected comparing with an unknown user to fail")
+	}
+	if _, err := userService.UpdatePrivacy(bob.ID, models.PrivacyUpdateRequest{Visibility: models.ProfileVisibilityPrivate}); err != nil {
+		t.Fatalf("Failed to update privacy: %v", err)
+	}
+	if _, err := service.Compare(alice, "bob"); err == nil || err.Error() != "library is private" {
+		t.Errorf("Expected a private library to be refused, got %v", err)
+	}
+
+	t.Logf("✓ Libraries compared with privacy settings applied")
+}
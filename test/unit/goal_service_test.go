@@ -0,0 +1,115 @@
+package unit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/goal"
+	grpchandler "github.com/tnphucccc/mangahub/internal/grpc"
+	"github.com/tnphucccc/mangahub/internal/grpc/pb"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestGoalPeriod_Window(t *testing.T) {
+	// Sunday 18 October 2026
+	now := time.Date(2026, time.October, 18, 22, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		period models.GoalPeriod
+		start  time.Time
+		end    time.Time
+		key    string
+	}{
+		{models.GoalPeriodWeek, time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC), time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), "2026-W42"},
+		{models.GoalPeriodMonth, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), "2026-10"},
+		{models.GoalPeriodYear, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), "2026"},
+	}
+
+	for _, tt := range tests {
+		start, end := tt.period.Window(now)
+		if !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Errorf("%s window = [%v, %v), expected [%v, %v)", tt.period, start, end, tt.start, tt.end)
+		}
+		if key := tt.period.Key(now); key != tt.key {
+			t.Errorf("%s key = %q, expected %q", tt.period, key, tt.key)
+		}
+	}
+
+	t.Logf("✓ Goal periods cover the expected windows")
+}
+
+func TestGoalCreateRequest_Binding(t *testing.T) {
+	bind := func(body string) error {
+		var req models.GoalCreateRequest
+		return bindJSON("POST", "/users/goals", body, &req)
+	}
+
+	valid := []string{
+		`{"metric": "chapters", "period": "year", "target": 1000}`,
+		`{"metric": "completed_series", "period": "month", "target": 2, "genre": "Action"}`,
+	}
+	for _, body := range valid {
+		if err := bind(body); err != nil {
+			t.Errorf("Expected %s to bind, got %v", body, err)
+		}
+	}
+
+	invalid := []string{
+		`{"metric": "pages", "period": "year", "target": 10}`,
+		`{"metric": "chapters", "period": "decade", "target": 10}`,
+		`{"metric": "chapters", "period": "year", "target": 0}`,
+		`{"metric": "chapters", "period": "year"}`,
+	}
+	for _, body := range invalid {
+		if err := bind(body); err == nil {
+			t.Errorf("Expected %s to be rejected", body)
+		}
+	}
+
+	t.Logf("✓ Goal requests validated")
+}
+
+func TestGRPCUpdateProgress_ReachesGoal(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "manga-001", "Oda", 100, "Action")
+	mangaService := newMangaService(db)
+	goalService := goal.NewService(goal.NewRepository(db), mangaService)
+	mangaService.OnProgressUpdate(goalService.CheckGoals)
+	userService := user.NewService(user.NewRepository(db), nil)
+	server := grpchandler.NewServer(mangaService, stats.NewService(stats.NewRepository(db)), userService, profile.NewService(userService, mangaService))
+
+	created, err := goalService.Create(alice.ID, models.GoalCreateRequest{Metric: models.GoalMetricChapters, Period: models.GoalPeriodWeek, Target: 10})
+	if err != nil {
+		t.Fatalf("Failed to create goal: %v", err)
+	}
+	if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+		t.Fatalf("Failed to add to library: %v", err)
+	}
+
+	chapter := 12.0
+	if _, err := server.UpdateProgress(context.Background(), &pb.UpdateProgressRequest{UserId: alice.ID, MangaId: "manga-001", Chapter: &chapter}); err != nil {
+		t.Fatalf("UpdateProgress failed: %v", err)
+	}
+
+	progress, err := goalService.Get(alice.ID, created.ID)
+	if err != nil || !progress.Achieved || progress.Current != 12 {
+		t.Errorf("Expected the goal to be reached at 12 chapters, got %+v (%v)", progress, err)
+	}
+
+	select {
+	case notification := <-mangaService.UDPNotificationChan:
+		if notification.UserID != alice.ID || !strings.HasPrefix(notification.Message, "Goal reached") {
+			t.Errorf("Expected a goal notification for alice, got %+v", notification)
+		}
+	default:
+		t.Error("Expected a goal notification to be queued for the UDP server")
+	}
+
+	t.Logf("✓ Progress updated over gRPC moves reading goals")
+}
@@ -130,7 +130,7 @@ func TestGRPCUserProgress_Structure(t *testing.T) {
 	}
 
 	if progress.CurrentChapter != 50 {
-		t.Errorf("Expected chapter 50, got %d", progress.CurrentChapter)
+		t.Errorf("Expected chapter 50, got %g", progress.CurrentChapter)
 	}
 
 	if progress.Rating != 8 {
@@ -141,11 +141,12 @@ func TestGRPCUserProgress_Structure(t *testing.T) {
 }
 
 func TestGRPCUpdateProgressRequest_Structure(t *testing.T) {
+	chapter := 50.5
 	req := &pb.UpdateProgressRequest{
 		UserId:  "user-123",
 		MangaId: "manga-123",
 		Status:  "reading",
-		Chapter: 50,
+		Chapter: &chapter,
 		Rating:  8,
 	}
 
@@ -153,8 +154,8 @@ func TestGRPCUpdateProgressRequest_Structure(t *testing.T) {
 		t.Errorf("Expected user ID 'user-123', got '%s'", req.UserId)
 	}
 
-	if req.Chapter != 50 {
-		t.Errorf("Expected chapter 50, got %d", req.Chapter)
+	if req.GetChapter() != 50.5 {
+		t.Errorf("Expected chapter 50.5, got %g", req.GetChapter())
 	}
 
 	if req.Rating != 8 {
@@ -186,6 +187,37 @@ func TestGRPCUpdateProgressResponse_Structure(t *testing.T) {
 	t.Logf("✓ gRPC UpdateProgressResponse structure correct")
 }
 
+func TestGRPCCatalogChange_Structure(t *testing.T) {
+	changes := []*pb.CatalogChange{
+		{
+			Token:   "41",
+			Type:    models.MangaChangeUpdated,
+			MangaId: "manga-123",
+			Manga:   &pb.MangaResponse{Id: "manga-123", Title: "One Piece"},
+		},
+		{
+			Token:   "42",
+			Type:    models.MangaChangeDeleted,
+			MangaId: "manga-456",
+		},
+	}
+
+	if changes[0].GetManga().GetTitle() != "One Piece" {
+		t.Errorf("Expected updated change to carry the manga body, got %v", changes[0].GetManga())
+	}
+
+	if changes[1].GetManga() != nil {
+		t.Errorf("Expected deleted change without a body, got %v", changes[1].GetManga())
+	}
+
+	req := &pb.CatalogChangesRequest{Since: changes[1].GetToken(), Follow: true}
+	if req.GetSince() != "42" || !req.GetFollow() {
+		t.Errorf("Expected request to resume after token 42 and follow, got %+v", req)
+	}
+
+	t.Logf("✓ gRPC CatalogChange structure correct")
+}
+
 // ==========================================
 // gRPC Model Conversion Tests
 // ==========================================
@@ -276,18 +308,22 @@ func TestGRPCToModelConversion_SearchQuery(t *testing.T) {
 
 func TestGRPCToModelConversion_ProgressUpdate(t *testing.T) {
 	// Test converting gRPC progress update to internal model
+	chapterVal := 10.5
 	grpcReq := &pb.UpdateProgressRequest{
 		UserId:  "user-123",
 		MangaId: "manga-123",
 		Status:  "reading",
-		Chapter: 50,
+		Chapter: &chapterVal,
 		Rating:  8,
 	}
 
 	// Simulate conversion
 	status := models.ReadingStatus(grpcReq.Status)
 	rating := int(grpcReq.Rating)
-	chapter := int(grpcReq.Chapter)
+	chapter, err := models.NewChapterNumber(grpcReq.GetChapter())
+	if err != nil {
+		t.Fatalf("Unexpected chapter conversion error: %v", err)
+	}
 
 	updateReq := models.ProgressUpdateRequest{
 		CurrentChapter: &chapter,
@@ -295,8 +331,8 @@ func TestGRPCToModelConversion_ProgressUpdate(t *testing.T) {
 		Rating:         &rating,
 	}
 
-	if *updateReq.CurrentChapter != int(grpcReq.Chapter) {
-		t.Errorf("Expected chapter %d, got %d", grpcReq.Chapter, *updateReq.CurrentChapter)
+	if updateReq.CurrentChapter.Float64() != grpcReq.GetChapter() {
+		t.Errorf("Expected chapter %g, got %s", grpcReq.GetChapter(), *updateReq.CurrentChapter)
 	}
 
 	if *updateReq.Status != models.ReadingStatus(grpcReq.Status) {
@@ -437,7 +473,6 @@ func TestGRPCErrorScenarios(t *testing.T) {
 		req := &pb.UpdateProgressRequest{
 			UserId:  "",
 			MangaId: "manga-123",
-			Chapter: 50,
 		}
 
 		// Empty user ID should cause error
@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/database"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+// bindJSON binds body into dst the way a handler bound to method and path would
+func bindJSON(method, path, body string, dst interface{}) error {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(method, path, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c.ShouldBindJSON(dst)
+}
+
+// bindQuery binds the query string of a GET request to path into dst
+func bindQuery(path string, dst interface{}) error {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", path, nil)
+	return c.ShouldBindQuery(dst)
+}
+
+// newTestDB returns a migrated SQLite database that lives as long as the test
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	config := database.DefaultConfig()
+	config.Path = filepath.Join(t.TempDir(), "mangahub.db")
+	db, err := database.Connect(config)
+	if err != nil {
+		t.Fatalf("Failed to open test database: %v", err)
+	}
+	t.Cleanup(func() { database.Close(db) })
+
+	if err := database.NewMigrator(db, "../../migrations").Up(); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+// seedUser creates a user whose ID and email derive from username
+func seedUser(t *testing.T, db *sql.DB, username string) *models.User {
+	t.Helper()
+
+	u := &models.User{ID: "user-" + username, Username: username, Email: username + "@example.com", PasswordHash: "x"}
+	if err := user.NewRepository(db).Create(u); err != nil {
+		t.Fatalf("Failed to seed user %s: %v", username, err)
+	}
+	return u
+}
+
+// seedManga adds a manga to the catalog. totalChapters 0 means the count is unknown.
+func seedManga(t *testing.T, db *sql.DB, id, author string, totalChapters int, genres ...string) {
+	t.Helper()
+
+	genresJSON, _ := json.Marshal(genres)
+	_, err := db.Exec(`
+		INSERT INTO manga (id, title, author, genres, status, total_chapters, description, cover_image_url)
+		VALUES (?, ?, ?, ?, 'ongoing', ?, '', '')
+	`, id, "Title of "+id, author, string(genresJSON), totalChapters)
+	if err != nil {
+		t.Fatalf("Failed to seed manga %s: %v", id, err)
+	}
+}
@@ -0,0 +1,82 @@
+package unit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/library"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	cases := map[string]string{
+		"ONE PIECE":                        "one piece",
+		"One-Piece":                        "one piece",
+		"  Berserk:  The Black Swordsman ": "berserk the black swordsman",
+		"She's Likely Aiming for My Older Brother": "she s likely aiming for my older brother",
+		"進撃の巨人":                                    "進撃の巨人",
+		"!!!":                                      "",
+	}
+
+	for input, expected := range cases {
+		if got := library.NormalizeTitle(input); got != expected {
+			t.Errorf("NormalizeTitle(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+
+	t.Logf("✓ Titles normalized for catalog matching")
+}
+
+func TestLibraryExportQuery_Formats(t *testing.T) {
+	bind := func(rawQuery string) error {
+		var query models.LibraryExportQuery
+		return bindQuery("/users/library/export?"+rawQuery, &query)
+	}
+
+	for _, format := range []string{"", models.ExportFormatMAL, models.ExportFormatCSV, models.ExportFormatJSON} {
+		if err := bind("format=" + format); err != nil {
+			t.Errorf("Expected format '%s' to be accepted, got %v", format, err)
+		}
+	}
+
+	if err := bind("format=xlsx"); err == nil {
+		t.Errorf("Expected format 'xlsx' to be rejected")
+	}
+
+	t.Logf("✓ Export formats validated")
+}
+
+func TestLibraryImport_DecompressedSizeCap(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	service := library.NewService(newMangaService(db))
+
+	gzipped := func(parts ...string) *bytes.Buffer {
+		var buf bytes.Buffer
+		gz, _ := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+		for _, part := range parts {
+			gz.Write([]byte(part))
+		}
+		gz.Close()
+		return &buf
+	}
+
+	result, err := service.Import(alice.ID, models.LibraryImportQuery{DryRun: true}, gzipped("<myanimelist></myanimelist>"))
+	if err != nil || result == nil {
+		t.Fatalf("Expected a small compressed export to import, got %v", err)
+	}
+
+	// Well under the 10 MB upload limit compressed, over 50 MB decompressed
+	bomb := gzipped("<myanimelist>", strings.Repeat(" ", 51<<20), "</myanimelist>")
+	if bomb.Len() > 10<<20 {
+		t.Fatalf("Expected the compressed export to fit the upload limit, got %d bytes", bomb.Len())
+	}
+	_, err = service.Import(alice.ID, models.LibraryImportQuery{DryRun: true}, bomb)
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid import file") {
+		t.Errorf("Expected an oversized decompressed export to be an invalid import file, got %v", err)
+	}
+
+	t.Logf("✓ Compressed exports capped by decompressed size")
+}
@@ -1,8 +1,17 @@
 package unit
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/mattn/go-sqlite3"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/user"
 	"github.com/tnphucccc/mangahub/pkg/models"
 )
 
@@ -90,7 +99,7 @@ func TestMangaStatus_Constants(t *testing.T) {
 func TestProgressUpdateRequest(t *testing.T) {
 	status := models.ReadingStatusReading
 	rating := 8
-	chapter := 50
+	chapter := models.ChapterNumber(50)
 
 	req := models.ProgressUpdateRequest{
 		CurrentChapter: &chapter,
@@ -99,7 +108,7 @@ func TestProgressUpdateRequest(t *testing.T) {
 	}
 
 	if *req.CurrentChapter != 50 {
-		t.Errorf("Expected chapter 50, got %d", *req.CurrentChapter)
+		t.Errorf("Expected chapter 50, got %s", *req.CurrentChapter)
 	}
 
 	if *req.Status != models.ReadingStatusReading {
@@ -175,3 +184,461 @@ func TestUserProgress_SafeGetters(t *testing.T) {
 
 	t.Logf("✓ UserProgress safe getters working")
 }
+
+func TestReadingStatus_Transitions(t *testing.T) {
+	tests := []struct {
+		from    models.ReadingStatus
+		to      models.ReadingStatus
+		allowed bool
+	}{
+		{models.ReadingStatusPlanToRead, models.ReadingStatusReading, true},
+		{models.ReadingStatusReading, models.ReadingStatusCompleted, true},
+		{models.ReadingStatusReading, models.ReadingStatusReading, true},
+		{models.ReadingStatusOnHold, models.ReadingStatusReading, true},
+		{models.ReadingStatusCompleted, models.ReadingStatusReading, true},
+		{models.ReadingStatusDropped, models.ReadingStatusPlanToRead, true},
+		{models.ReadingStatusReading, models.ReadingStatusPlanToRead, false},
+		{models.ReadingStatusDropped, models.ReadingStatusCompleted, false},
+		{models.ReadingStatusCompleted, models.ReadingStatusDropped, false},
+		{models.ReadingStatusReading, models.ReadingStatus("finished"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.allowed {
+			t.Errorf("CanTransitionTo(%s -> %s) = %v, want %v", tt.from, tt.to, got, tt.allowed)
+		}
+	}
+
+	if models.ReadingStatus("finished").IsValid() {
+		t.Errorf("Expected unknown status to be invalid")
+	}
+
+	t.Logf("✓ Reading status transitions enforced")
+}
+
+// newMangaService returns a manga service backed by db
+func newMangaService(db *sql.DB) *manga.Service {
+	return manga.NewService(manga.NewRepository(db), user.NewRepository(db))
+}
+
+func chapterPtr(n float64) *models.ChapterNumber {
+	c := models.ChapterNumber(n)
+	return &c
+}
+
+func statusPtr(s models.ReadingStatus) *models.ReadingStatus {
+	return &s
+}
+
+func TestUpdateProgress_StatusRules(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "finite", "Oda", 50)
+	seedManga(t, db, "ongoing", "Oda", 0)
+	service := newMangaService(db)
+
+	for _, id := range []string{"finite", "ongoing"} {
+		if err := service.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusPlanToRead}); err != nil {
+			t.Fatalf("Failed to add %s: %v", id, err)
+		}
+	}
+
+	progress := func(mangaID string) *models.UserProgress {
+		p, err := service.GetProgress(alice.ID, mangaID)
+		if err != nil {
+			t.Fatalf("Failed to get progress: %v", err)
+		}
+		return p
+	}
+	transitionCode := func(err error) string {
+		var transitionErr *manga.TransitionError
+		if errors.As(err, &transitionErr) {
+			return transitionErr.Code
+		}
+		return ""
+	}
+
+	// Starting to read stamps started_at
+	if err := service.UpdateProgress(alice.ID, "finite", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(10), Status: statusPtr(models.ReadingStatusReading)}); err != nil {
+		t.Fatalf("Failed to start reading: %v", err)
+	}
+	if p := progress("finite"); p.Status != models.ReadingStatusReading || !p.StartedAt.Valid || p.CompletedAt.Valid {
+		t.Errorf("Expected reading with started_at only, got %+v", p)
+	}
+
+	err := service.UpdateProgress(alice.ID, "finite", models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusCompleted)})
+	if code := transitionCode(err); code != manga.ErrCodeChaptersIncomplete {
+		t.Errorf("Expected %s completing at chapter 10 of 50, got %v", manga.ErrCodeChaptersIncomplete, err)
+	}
+
+	// Resending the current status, as the CLI does, still completes at the final chapter
+	if err := service.UpdateProgress(alice.ID, "finite", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(50), Status: statusPtr(models.ReadingStatusReading)}); err != nil {
+		t.Fatalf("Failed to reach the final chapter: %v", err)
+	}
+	if p := progress("finite"); p.Status != models.ReadingStatusCompleted || !p.CompletedAt.Valid {
+		t.Errorf("Expected the final chapter to complete the entry, got %+v", p)
+	}
+
+	err = service.UpdateProgress(alice.ID, "finite", models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusDropped)})
+	if code := transitionCode(err); code != manga.ErrCodeInvalidTransition {
+		t.Errorf("Expected %s dropping a completed entry, got %v", manga.ErrCodeInvalidTransition, err)
+	}
+	err = service.UpdateProgress(alice.ID, "finite", models.ProgressUpdateRequest{Status: statusPtr("finished")})
+	if code := transitionCode(err); code != manga.ErrCodeInvalidStatus {
+		t.Errorf("Expected %s for an unknown status, got %v", manga.ErrCodeInvalidStatus, err)
+	}
+
+	// Without a known chapter count nothing completes by itself
+	if err := service.UpdateProgress(alice.ID, "ongoing", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(999), Status: statusPtr(models.ReadingStatusReading)}); err != nil {
+		t.Fatalf("Failed to update ongoing series: %v", err)
+	}
+	if p := progress("ongoing"); p.Status != models.ReadingStatusReading || p.CompletedAt.Valid {
+		t.Errorf("Expected an ongoing series to stay reading, got %+v", p)
+	}
+
+	t.Logf("✓ Status rules enforced on stored progress")
+}
+
+// lostRaces counts the progress writes the lose_race() SQL function still drops
+var lostRaces int32
+
+// lostRaceDriver is SQLite with lose_race(), which reports true (once per count)
+// while lostRaces is positive
+const lostRaceDriver = "sqlite3_lost_races"
+
+func init() {
+	sql.Register(lostRaceDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("lose_race", func() bool {
+				for {
+					n := atomic.LoadInt32(&lostRaces)
+					if n <= 0 {
+						return false
+					}
+					if atomic.CompareAndSwapInt32(&lostRaces, n, n-1) {
+						return true
+					}
+				}
+			}, false)
+		},
+	})
+}
+
+func TestUpdateProgress_VersionConflicts(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "manga-001", "Oda", 100)
+	repo := manga.NewRepository(db)
+	service := newMangaService(db)
+
+	if err := service.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+		t.Fatalf("Failed to add to library: %v", err)
+	}
+	progress := func() *models.UserProgress {
+		p, err := service.GetProgress(alice.ID, "manga-001")
+		if err != nil {
+			t.Fatalf("Failed to get progress: %v", err)
+		}
+		return p
+	}
+
+	// The repository refuses a write based on a version that has moved on
+	stale := progress()
+	if err := service.UpdateProgress(alice.ID, "manga-001", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(5)}); err != nil {
+		t.Fatalf("Failed to update progress: %v", err)
+	}
+	next := *stale
+	next.CurrentChapter = 7
+	if err := repo.UpdateProgress(&next, stale); !errors.Is(err, manga.ErrProgressConflict) {
+		t.Errorf("Expected ErrProgressConflict writing over a stale version, got %v", err)
+	}
+
+	// A checked update against the stale version is rejected with the current progress
+	err := service.UpdateProgress(alice.ID, "manga-001", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(7), Version: &stale.Version})
+	var conflictErr *manga.ConflictError
+	if !errors.Is(err, manga.ErrProgressConflict) || !errors.As(err, &conflictErr) || conflictErr.Current.CurrentChapter != 5 {
+		t.Fatalf("Expected a conflict carrying chapter 5, got %v", err)
+	}
+
+	// Lose the race for the next writes: the trigger drops that many updates as
+	// if another write had bumped the version first. The count lives outside the
+	// database so the rollback of a lost write does not restore it.
+	var file string
+	if err := db.QueryRow(`SELECT file FROM pragma_database_list WHERE name = 'main'`).Scan(&file); err != nil {
+		t.Fatalf("Failed to find database file: %v", err)
+	}
+	racyDB, err := sql.Open(lostRaceDriver, file)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer racyDB.Close()
+	if _, err := racyDB.Exec(`
+		CREATE TRIGGER lose_race BEFORE UPDATE ON user_progress WHEN lose_race()
+		BEGIN
+			SELECT RAISE(IGNORE);
+		END
+	`); err != nil {
+		t.Fatalf("Failed to install trigger: %v", err)
+	}
+	service = newMangaService(racyDB)
+	loseRaces := func(n int32) { atomic.StoreInt32(&lostRaces, n) }
+
+	// An unchecked update is re-applied until it lands
+	loseRaces(2)
+	if err := service.UpdateProgress(alice.ID, "manga-001", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(8)}); err != nil {
+		t.Fatalf("Expected the update to be retried, got %v", err)
+	}
+	if p := progress(); p.CurrentChapter != 8 || p.Version != 3 {
+		t.Errorf("Expected chapter 8 at version 3, got chapter %g at version %d", float64(p.CurrentChapter), p.Version)
+	}
+
+	// ...and gives up with a conflict once the attempts run out
+	loseRaces(3)
+	err = service.UpdateProgress(alice.ID, "manga-001", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(9)})
+	if !errors.Is(err, manga.ErrProgressConflict) || !errors.As(err, &conflictErr) {
+		t.Errorf("Expected a conflict after every attempt lost, got %v", err)
+	}
+	if p := progress(); p.CurrentChapter != 8 {
+		t.Errorf("Expected the failed update to leave chapter 8, got %g", float64(p.CurrentChapter))
+	}
+
+	t.Logf("✓ Version conflicts detected and retried on stored progress")
+}
+
+func TestUpdateProgress_Rereads(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	seedManga(t, db, "manga-001", "Oda", 10)
+	service := newMangaService(db)
+
+	if err := service.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+		t.Fatalf("Failed to add to library: %v", err)
+	}
+	update := func(req models.ProgressUpdateRequest) *models.UserProgress {
+		t.Helper()
+		if err := service.UpdateProgress(alice.ID, "manga-001", req); err != nil {
+			t.Fatalf("Failed to update progress: %v", err)
+		}
+		p, err := service.GetProgress(alice.ID, "manga-001")
+		if err != nil {
+			t.Fatalf("Failed to get progress: %v", err)
+		}
+		return p
+	}
+	readThroughs := func() []models.ReadThrough {
+		t.Helper()
+		reads, err := service.GetReadThroughs(alice.ID, "manga-001")
+		if err != nil {
+			t.Fatalf("Failed to list read-throughs: %v", err)
+		}
+		return reads
+	}
+	rating := func(n int) *int { return &n }
+
+	update(models.ProgressUpdateRequest{CurrentChapter: chapterPtr(10), Rating: rating(8)})
+
+	// Moving back to plan_to_read undoes the completion without counting a reread
+	p := update(models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusPlanToRead)})
+	if p.RereadCount != 0 || p.CompletedAt.Valid || p.Rating == nil || *p.Rating != 8 {
+		t.Errorf("Expected an uncounted, still rated plan_to_read entry, got %+v", p)
+	}
+	if reads := readThroughs(); len(reads) != 1 || !reads[0].Current {
+		t.Errorf("Expected only the current pass, got %+v", reads)
+	}
+
+	// Completing again and going back to reading is a reread: the pass and its
+	// rating are archived and the new pass starts unrated
+	update(models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusReading)})
+	update(models.ProgressUpdateRequest{CurrentChapter: chapterPtr(10)})
+	p = update(models.ProgressUpdateRequest{CurrentChapter: chapterPtr(1), Status: statusPtr(models.ReadingStatusReading)})
+	if p.RereadCount != 1 || p.Rating != nil || !p.StartedAt.Valid {
+		t.Errorf("Expected the first reread to start unrated, got %+v", p)
+	}
+	reads := readThroughs()
+	if len(reads) != 2 || reads[0].Rating == nil || *reads[0].Rating != 8 || reads[0].Chapter != 10 {
+		t.Errorf("Expected the finished pass to be archived with its rating, got %+v", reads)
+	}
+
+	// StartReread counts too; a rating sent with a manual reread belongs to the new pass
+	update(models.ProgressUpdateRequest{CurrentChapter: chapterPtr(10), Rating: rating(9)})
+	if err := service.StartReread(alice.ID, "manga-001"); err != nil {
+		t.Fatalf("Failed to start reread: %v", err)
+	}
+	update(models.ProgressUpdateRequest{CurrentChapter: chapterPtr(10)})
+	p = update(models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusReading), Rating: rating(6)})
+	if p.RereadCount != 3 || p.Rating == nil || *p.Rating != 6 {
+		t.Errorf("Expected a third reread rated 6, got %+v", p)
+	}
+	if reads := readThroughs(); len(reads) != 4 || *reads[1].Rating != 9 || reads[2].Rating != nil {
+		t.Errorf("Expected each archived pass to keep its own rating, got %+v", reads)
+	}
+
+	t.Logf("✓ Rereads counted only when reading a completed series again")
+}
+
+func TestGetChapterSuggestions_HighWaterMark(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	seedManga(t, db, "ongoing", "Miura", 0)
+	seedManga(t, db, "finite", "Oda", 50)
+	service := newMangaService(db)
+
+	for _, u := range []*models.User{alice, bob} {
+		for _, id := range []string{"ongoing", "finite"} {
+			if err := service.AddToLibrary(u.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusReading}); err != nil {
+				t.Fatalf("Failed to add to library: %v", err)
+			}
+		}
+	}
+	update := func(u *models.User, chapter float64) {
+		t.Helper()
+		if err := service.UpdateProgress(u.ID, "ongoing", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(chapter)}); err != nil {
+			t.Fatalf("Failed to update progress: %v", err)
+		}
+	}
+
+	// Alice went back after reading further; bob reached 120.5 before finishing and rereading
+	update(alice, 90)
+	update(alice, 40)
+	update(bob, 120.5)
+	if err := service.UpdateProgress(bob.ID, "ongoing", models.ProgressUpdateRequest{Status: statusPtr(models.ReadingStatusCompleted)}); err != nil {
+		t.Fatalf("Failed to complete: %v", err)
+	}
+	if err := service.StartReread(bob.ID, "ongoing"); err != nil {
+		t.Fatalf("Failed to start reread: %v", err)
+	}
+	update(bob, 3)
+	if _, err := db.Exec(`DELETE FROM progress_history WHERE user_id = ?`, bob.ID); err != nil {
+		t.Fatalf("Failed to clear history: %v", err)
+	}
+
+	suggestions, err := service.GetChapterSuggestions()
+	if err != nil {
+		t.Fatalf("Failed to get suggestions: %v", err)
+	}
+	if len(suggestions) != 1 {
+		t.Fatalf("Expected a suggestion for the ongoing series only, got %+v", suggestions)
+	}
+	if s := suggestions[0]; s.MangaID != "ongoing" || s.HighestChapter != 120.5 || s.SuggestedTotal != 120 || s.Readers != 2 {
+		t.Errorf("Expected chapter 120.5 from bob's archived pass and 2 readers, got %+v", s)
+	}
+
+	t.Logf("✓ Chapter suggestions keep the highest chapter ever reached")
+}
+
+func TestLibraryQuery_Binding(t *testing.T) {
+	bind := func(rawQuery string) (models.LibraryQuery, error) {
+		var query models.LibraryQuery
+		err := bindQuery("/users/library?"+rawQuery, &query)
+		return query, err
+	}
+
+	query, err := bind("status=reading&genre=Action&q=piece&min_rating=7&has_unread=true&sort=progress&order=asc&limit=20&offset=40")
+	if err != nil {
+		t.Fatalf("Unexpected binding error: %v", err)
+	}
+	if query.Status != models.ReadingStatusReading || query.Genre != "Action" || query.Q != "piece" {
+		t.Errorf("Filters not bound correctly: %+v", query)
+	}
+	if query.MinRating != 7 || query.HasUnread == nil || !*query.HasUnread {
+		t.Errorf("Rating/unread filters not bound correctly: %+v", query)
+	}
+	if query.Sort != models.LibrarySortProgress || query.Order != "asc" || query.Limit != 20 || query.Offset != 40 {
+		t.Errorf("Sort/paging not bound correctly: %+v", query)
+	}
+
+	invalid := []string{"sort=random", "order=sideways", "min_rating=11", "limit=500", "offset=-1"}
+	for _, rawQuery := range invalid {
+		if _, err := bind(rawQuery); err == nil {
+			t.Errorf("Expected binding error for '%s'", rawQuery)
+		}
+	}
+
+	t.Logf("✓ LibraryQuery binding and validation correct")
+}
+
+func TestReadThrough_JSON(t *testing.T) {
+	rating := 9
+	current := models.ReadThrough{Number: 2, Chapter: 4, Rating: &rating, Current: true}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		t.Fatalf("Failed to marshal read-through: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal read-through: %v", err)
+	}
+
+	if decoded["completed_at"] != nil {
+		t.Errorf("Expected unfinished pass to have null completed_at, got %v", decoded["completed_at"])
+	}
+	if decoded["number"] != float64(2) || decoded["current"] != true {
+		t.Errorf("Unexpected read-through JSON: %s", data)
+	}
+
+	progress, _ := json.Marshal(models.UserProgress{RereadCount: 1})
+	if !strings.Contains(string(progress), `"reread_count":1`) {
+		t.Errorf("Expected reread_count in progress JSON, got %s", progress)
+	}
+
+	t.Logf("✓ Read-through serialized")
+}
+
+func TestProgressUpdateRequest_ConflictBinding(t *testing.T) {
+	bind := func(body string) (models.ProgressUpdateRequest, error) {
+		var req models.ProgressUpdateRequest
+		err := bindJSON("PUT", "/users/progress/one-piece", body, &req)
+		return req, err
+	}
+
+	req, err := bind(`{"current_chapter": 12, "version": 3, "on_conflict": "highest_chapter"}`)
+	if err != nil {
+		t.Fatalf("Unexpected binding error: %v", err)
+	}
+	if req.Version == nil || *req.Version != 3 || req.OnConflict != models.ConflictPolicyHighestChapter {
+		t.Errorf("Version/conflict policy not bound correctly: %+v", req)
+	}
+
+	req, err = bind(`{"current_chapter": 12}`)
+	if err != nil || req.Version != nil || req.OnConflict != "" {
+		t.Errorf("Expected unconditional update without version, got %+v (%v)", req, err)
+	}
+
+	for _, body := range []string{`{"on_conflict": "newest"}`, `{"version": 0}`} {
+		if _, err := bind(body); err == nil {
+			t.Errorf("Expected binding error for %s", body)
+		}
+	}
+
+	t.Logf("✓ Progress version and conflict policy binding correct")
+}
+
+func TestMangaSearchQuery_UpdatedSinceBinding(t *testing.T) {
+	bind := func(rawQuery string) (models.MangaSearchQuery, error) {
+		var query models.MangaSearchQuery
+		err := bindQuery("/manga?"+rawQuery, &query)
+		return query, err
+	}
+
+	query, err := bind("sort=updated&updated_since=2025-11-27T03:08:20Z")
+	if err != nil {
+		t.Fatalf("Unexpected binding error: %v", err)
+	}
+	if query.Sort != models.MangaSortUpdated || !query.UpdatedSince.Equal(time.Date(2025, 11, 27, 3, 8, 20, 0, time.UTC)) {
+		t.Errorf("Sort/updated_since not bound correctly: %+v", query)
+	}
+
+	query, err = bind("title=naruto")
+	if err != nil || !query.UpdatedSince.IsZero() {
+		t.Errorf("Expected no updated_since filter, got %+v (%v)", query, err)
+	}
+
+	if _, err := bind("updated_since=yesterday"); err == nil {
+		t.Error("Expected binding error for a malformed updated_since")
+	}
+
+	t.Logf("✓ Manga search updated_since binding correct")
+}
@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/shelf"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestProfileCanView(t *testing.T) {
+	owner := &models.User{ID: "owner", Username: "owner"}
+	follower := &models.User{ID: "follower", Username: "follower"}
+	stranger := &models.User{ID: "stranger", Username: "stranger"}
+
+	service := profile.NewService(nil, nil)
+	service.UseFollowerCheck(func(followerID, userID string) (bool, error) {
+		return followerID == "follower" && userID == "owner", nil
+	})
+
+	tests := []struct {
+		name       string
+		visibility models.ProfileVisibility
+		viewer     *models.User
+		want       bool
+	}{
+		{"public to anonymous", models.ProfileVisibilityPublic, nil, true},
+		{"public to stranger", models.ProfileVisibilityPublic, stranger, true},
+		{"followers to anonymous", models.ProfileVisibilityFollowers, nil, false},
+		{"followers to stranger", models.ProfileVisibilityFollowers, stranger, false},
+		{"followers to follower", models.ProfileVisibilityFollowers, follower, true},
+		{"private to follower", models.ProfileVisibilityPrivate, follower, false},
+		{"private to owner", models.ProfileVisibilityPrivate, owner, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner.Visibility = tt.visibility
+			got, err := service.CanView(tt.viewer, owner)
+			if err != nil {
+				t.Fatalf("CanView returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CanView = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReservedUsername(t *testing.T) {
+	for _, name := range []string{"me", "Library", "QUEUE"} {
+		if !models.IsReservedUsername(name) {
+			t.Errorf("Expected %q to be reserved", name)
+		}
+	}
+	if models.IsReservedUsername("alice") {
+		t.Errorf("Expected 'alice' not to be reserved")
+	}
+}
+
+func TestProfileGetLibrary_PublicShelves(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	seedManga(t, db, "manga-001", "Oda", 100)
+	seedManga(t, db, "manga-002", "Togashi", 50)
+
+	mangaService := newMangaService(db)
+	userService := user.NewService(user.NewRepository(db), nil)
+	shelfService := shelf.NewService(shelf.NewRepository(db))
+	service := profile.NewService(userService, mangaService)
+
+	for _, id := range []string{"manga-001", "manga-002"} {
+		if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add to library: %v", err)
+		}
+	}
+	pulls, err := shelfService.Create(alice.ID, models.ShelfCreateRequest{Name: "Weekly pulls", Visibility: models.ShelfVisibilityPublic})
+	if err != nil {
+		t.Fatalf("Failed to create shelf: %v", err)
+	}
+	secret, err := shelfService.Create(alice.ID, models.ShelfCreateRequest{Name: "Guilty pleasures"})
+	if err != nil {
+		t.Fatalf("Failed to create shelf: %v", err)
+	}
+	for shelfID, mangaID := range map[string]string{pulls.ID: "manga-001", secret.ID: "manga-002"} {
+		if err := shelfService.AddEntry(alice.ID, shelfID, mangaID); err != nil {
+			t.Fatalf("Failed to shelve entry: %v", err)
+		}
+	}
+	if _, err := shelfService.SetTags(alice.ID, "manga-001", models.TagsUpdateRequest{Tags: []string{"favorite"}}); err != nil {
+		t.Fatalf("Failed to tag entry: %v", err)
+	}
+
+	shelvesOf := func(library []models.UserProgressWithManga) map[string][]string {
+		shelves := map[string][]string{}
+		for _, entry := range library {
+			shelves[entry.MangaID] = entry.Shelves
+		}
+		return shelves
+	}
+
+	// Other users see public shelves only, and no tags
+	library, _, err := service.GetLibrary(bob, "alice", models.LibraryQuery{})
+	if err != nil {
+		t.Fatalf("GetLibrary failed: %v", err)
+	}
+	shelves := shelvesOf(library)
+	if len(shelves["manga-001"]) != 1 || shelves["manga-001"][0] != "Weekly pulls" || len(shelves["manga-002"]) != 0 {
+		t.Errorf("Expected only the public shelf to be listed, got %v", shelves)
+	}
+	for _, entry := range library {
+		if len(entry.Tags) != 0 {
+			t.Errorf("Expected tags to stay private, got %v on %s", entry.Tags, entry.MangaID)
+		}
+	}
+
+	// ...and can filter by them, by name or ID
+	for _, name := range []string{"weekly pulls", pulls.ID} {
+		library, total, err := service.GetLibrary(bob, "alice", models.LibraryQuery{Shelf: name})
+		if err != nil || total != 1 || library[0].MangaID != "manga-001" {
+			t.Errorf("Expected %q to match manga-001, got %d entries (%v)", name, total, err)
+		}
+	}
+	for _, name := range []string{"Guilty pleasures", secret.ID} {
+		if _, total, err := service.GetLibrary(bob, "alice", models.LibraryQuery{Shelf: name}); err != nil || total != 0 {
+			t.Errorf("Expected the private shelf %q to match nothing, got %d entries (%v)", name, total, err)
+		}
+	}
+	if _, _, err := service.GetLibrary(bob, "alice", models.LibraryQuery{Tag: "favorite"}); err == nil {
+		t.Error("Expected a tag filter on someone else's library to be rejected")
+	}
+
+	// The owner sees every shelf and tag
+	library, _, err = service.GetLibrary(alice, "alice", models.LibraryQuery{})
+	if err != nil {
+		t.Fatalf("GetLibrary failed: %v", err)
+	}
+	if shelves := shelvesOf(library); len(shelves["manga-002"]) != 1 {
+		t.Errorf("Expected the owner to see the private shelf, got %v", shelves)
+	}
+	if _, total, err := service.GetLibrary(alice, "alice", models.LibraryQuery{Shelf: "Guilty pleasures"}); err != nil || total != 1 {
+		t.Errorf("Expected the owner to filter by a private shelf, got %d entries (%v)", total, err)
+	}
+
+	t.Logf("✓ Public shelves shown to and filterable by other users")
+}
@@ -0,0 +1,146 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/queue"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func upNextItem(id string, status models.ReadingStatus, chapter float64, total, priority int, favorite bool, updated time.Time) models.UserProgressWithManga {
+	item := models.UserProgressWithManga{Manga: models.Manga{ID: id, TotalChapters: total}}
+	item.MangaID = id
+	item.Status = status
+	item.CurrentChapter = models.ChapterNumber(chapter)
+	item.Priority = priority
+	item.Favorite = favorite
+	item.UpdatedAt = updated
+	return item
+}
+
+func TestRankUpNext(t *testing.T) {
+	now := time.Now()
+	library := []models.UserProgressWithManga{
+		upNextItem("long", models.ReadingStatusReading, 10, 100, 0, false, now),
+		upNextItem("short", models.ReadingStatusReading, 95, 100, 0, false, now.Add(-time.Hour)),
+		upNextItem("unknown", models.ReadingStatusReading, 40, 0, 0, false, now),
+		upNextItem("favorite", models.ReadingStatusPlanToRead, 0, 200, 0, true, now.Add(-48*time.Hour)),
+		upNextItem("urgent", models.ReadingStatusPlanToRead, 0, 300, 3, false, now.Add(-72*time.Hour)),
+		upNextItem("caught-up", models.ReadingStatusReading, 50, 50, 3, true, now),
+		upNextItem("on-hold", models.ReadingStatusOnHold, 1, 10, 3, true, now),
+		upNextItem("queued", models.ReadingStatusOnHold, 1, 10, 0, false, now),
+		upNextItem("queued-done", models.ReadingStatusCompleted, 10, 10, 0, false, now),
+	}
+
+	entries := queue.RankUpNext(library, []string{"queued", "queued-done"}, 0)
+
+	expected := []string{"queued", "urgent", "favorite", "short", "long", "unknown"}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, id := range expected {
+		if entries[i].MangaID != id {
+			t.Errorf("Expected rank %d to be '%s', got '%s'", i+1, id, entries[i].MangaID)
+		}
+		if entries[i].Rank != i+1 {
+			t.Errorf("Expected '%s' to have rank %d, got %d", id, i+1, entries[i].Rank)
+		}
+	}
+
+	if !entries[0].Queued || entries[1].Queued {
+		t.Errorf("Expected only the queued entry to be marked queued")
+	}
+	if entries[3].UnreadChapters == nil || *entries[3].UnreadChapters != 5 {
+		t.Errorf("Expected 5 unread chapters, got %v", entries[3].UnreadChapters)
+	}
+	if entries[5].UnreadChapters != nil {
+		t.Errorf("Expected unknown unread chapters for an unknown total, got %v", *entries[5].UnreadChapters)
+	}
+
+	if limited := queue.RankUpNext(library, nil, 2); len(limited) != 2 || limited[0].MangaID != "urgent" {
+		t.Errorf("Expected the limit to keep the top 2 entries, got %d", len(limited))
+	}
+
+	t.Logf("✓ Up next ranks the queue, then priority, favorites, unread chapters and recency")
+}
+
+func TestQueueService_UpNext(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	for _, id := range []string{"manga-001", "manga-002", "manga-003", "manga-004", "manga-005"} {
+		seedManga(t, db, id, "Oda", 20)
+	}
+	seedManga(t, db, "not-in-library", "Oda", 20)
+
+	mangaService := newMangaService(db)
+	service := queue.NewService(queue.NewRepository(db), mangaService)
+
+	for _, id := range []string{"manga-001", "manga-002", "manga-003", "manga-004", "manga-005"} {
+		if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add to library: %v", err)
+		}
+	}
+	ids := func(entries []models.QueueEntry) []string {
+		out := make([]string, len(entries))
+		for i, e := range entries {
+			out[i] = e.MangaID
+		}
+		return out
+	}
+
+	if _, err := service.Add(alice.ID, models.QueueAddRequest{MangaID: "not-in-library"}); err == nil {
+		t.Error("Expected queueing a manga outside the library to fail")
+	}
+
+	first := 0
+	for _, req := range []models.QueueAddRequest{{MangaID: "manga-001"}, {MangaID: "manga-002"}, {MangaID: "manga-003", Position: &first}} {
+		if _, err := service.Add(alice.ID, req); err != nil {
+			t.Fatalf("Failed to queue %s: %v", req.MangaID, err)
+		}
+	}
+	queued, err := service.List(alice.ID)
+	if err != nil {
+		t.Fatalf("Failed to list queue: %v", err)
+	}
+	if got := ids(queued); len(got) != 3 || got[0] != "manga-003" || got[1] != "manga-001" || got[2] != "manga-002" {
+		t.Errorf("Expected manga-003, manga-001, manga-002, got %v", got)
+	}
+
+	for _, order := range [][]string{{"manga-001", "manga-001", "manga-002"}, {"manga-001", "manga-002"}} {
+		if _, err := service.Reorder(alice.ID, models.QueueOrderRequest{MangaIDs: order}); err == nil {
+			t.Errorf("Expected order %v to be rejected", order)
+		}
+	}
+	if queued, err = service.Reorder(alice.ID, models.QueueOrderRequest{MangaIDs: []string{"manga-002", "manga-003", "manga-001"}}); err != nil {
+		t.Fatalf("Failed to reorder: %v", err)
+	}
+
+	// Finished queued entries drop out; unqueued ones follow by priority
+	if err := mangaService.UpdateProgress(alice.ID, "manga-002", models.ProgressUpdateRequest{CurrentChapter: chapterPtr(20)}); err != nil {
+		t.Fatalf("Failed to finish manga-002: %v", err)
+	}
+	priority := 3
+	if _, err := mangaService.UpdateEntry(alice.ID, "manga-005", models.LibraryEntryUpdateRequest{Priority: &priority}); err != nil {
+		t.Fatalf("Failed to set priority: %v", err)
+	}
+
+	upNext, err := service.UpNext(alice.ID, models.UpNextQuery{Limit: 3})
+	if err != nil {
+		t.Fatalf("Failed to get up next: %v", err)
+	}
+	expected := []string{"manga-003", "manga-001", "manga-005"}
+	if len(upNext) != len(expected) {
+		t.Fatalf("Expected %v, got %d entries", expected, len(upNext))
+	}
+	for i, id := range expected {
+		if upNext[i].MangaID != id || upNext[i].Rank != i+1 {
+			t.Errorf("Expected rank %d to be %s, got %s (rank %d)", i+1, id, upNext[i].MangaID, upNext[i].Rank)
+		}
+	}
+	if !upNext[0].Queued || upNext[2].Queued || upNext[0].UnreadChapters == nil || *upNext[0].UnreadChapters != 20 {
+		t.Errorf("Expected the queued entry first with 20 unread chapters, got %+v", upNext[0])
+	}
+
+	t.Logf("✓ Queue kept in order and ranked first in up next")
+}
@@ -0,0 +1,63 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestNewCommunityRating(t *testing.T) {
+	if r := models.NewCommunityRating([10]int{}, 7); r != nil {
+		t.Errorf("Expected nil rating for an unrated manga, got %+v", r)
+	}
+
+	// Two ratings of 10 and one of 4 against a catalog mean of 7
+	histogram := [10]int{3: 1, 9: 2}
+	r := models.NewCommunityRating(histogram, 7)
+	if r == nil {
+		t.Fatal("Expected a rating")
+	}
+	if r.Count != 3 {
+		t.Errorf("Expected count 3, got %d", r.Count)
+	}
+	if r.Mean != 8 {
+		t.Errorf("Expected mean 8, got %v", r.Mean)
+	}
+	// (5*7 + 24) / (5 + 3) = 7.375, rounded to two decimals
+	if r.Score != 7.38 {
+		t.Errorf("Expected score 7.38, got %v", r.Score)
+	}
+
+	// A single perfect rating must not outrank a consistently well-rated series
+	single := models.NewCommunityRating([10]int{9: 1}, 7)
+	many := models.NewCommunityRating([10]int{8: 40}, 7)
+	if single.Score >= many.Score {
+		t.Errorf("Expected 40 ratings of 9 (%v) to outrank one rating of 10 (%v)", many.Score, single.Score)
+	}
+
+	t.Logf("✓ Community rating aggregated and weighted")
+}
+
+func TestCommunityRating_JSON(t *testing.T) {
+	m := models.Manga{ID: "one-piece", CommunityRating: models.NewCommunityRating([10]int{9: 1}, 10)}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Failed to marshal manga: %v", err)
+	}
+
+	var decoded struct {
+		CommunityRating struct {
+			Count     int   `json:"count"`
+			Histogram []int `json:"histogram"`
+		} `json:"community_rating"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal manga: %v", err)
+	}
+	if decoded.CommunityRating.Count != 1 || len(decoded.CommunityRating.Histogram) != 10 {
+		t.Errorf("Unexpected community rating JSON: %s", data)
+	}
+
+	t.Logf("✓ Community rating serialized with a 10-bucket histogram")
+}
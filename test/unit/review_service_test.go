@@ -0,0 +1,159 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/follow"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/review"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestReviewService_WriteAndVote(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	carol := seedUser(t, db, "carol")
+	seedManga(t, db, "manga-001", "Oda", 100, "Action")
+
+	mangaService := manga.NewService(manga.NewRepository(db), user.NewRepository(db))
+	service := review.NewService(review.NewRepository(db), mangaService)
+
+	if _, _, err := service.Write(alice.ID, "manga-001", models.ReviewRequest{Body: "Great"}); err == nil {
+		t.Fatal("Expected a review of a manga outside the library to be rejected")
+	}
+
+	for _, u := range []*models.User{alice, bob} {
+		if err := mangaService.AddToLibrary(u.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add to library: %v", err)
+		}
+	}
+
+	if _, _, err := service.Write(alice.ID, "manga-001", models.ReviewRequest{Body: "   "}); err == nil {
+		t.Error("Expected a blank review to be rejected")
+	}
+
+	first, created, err := service.Write(alice.ID, "manga-001", models.ReviewRequest{Body: "  A slow start.  "})
+	if err != nil || !created || first.Body != "A slow start." {
+		t.Fatalf("Expected a new trimmed review, got %+v, created=%v (%v)", first, created, err)
+	}
+	replaced, created, err := service.Write(alice.ID, "manga-001", models.ReviewRequest{Body: "Worth it.", Spoiler: true})
+	if err != nil || created || replaced.ID != first.ID || replaced.Body != "Worth it." || !replaced.Spoiler {
+		t.Fatalf("Expected the review to be replaced in place, got %+v, created=%v (%v)", replaced, created, err)
+	}
+	if _, _, err := service.Write(bob.ID, "manga-001", models.ReviewRequest{Body: "Meh."}); err != nil {
+		t.Fatalf("Failed to write review: %v", err)
+	}
+
+	if _, err := service.Vote(alice.ID, first.ID, true); err == nil {
+		t.Error("Expected a vote on your own review to be rejected")
+	}
+	for _, voter := range []*models.User{bob, carol, carol} {
+		if _, err := service.Vote(voter.ID, first.ID, true); err != nil {
+			t.Fatalf("Failed to vote: %v", err)
+		}
+	}
+
+	reviews, total, err := service.ListForManga(carol.ID, "manga-001", models.ReviewQuery{Sort: models.ReviewSortHelpful})
+	if err != nil {
+		t.Fatalf("Failed to list reviews: %v", err)
+	}
+	if total != 2 || len(reviews) != 2 || reviews[0].ID != first.ID || reviews[0].HelpfulCount != 2 {
+		t.Errorf("Expected alice's review first with 2 helpful votes, got %+v (total %d)", reviews, total)
+	}
+
+	if _, err := service.Vote(carol.ID, first.ID, false); err != nil {
+		t.Fatalf("Failed to withdraw vote: %v", err)
+	}
+	if _, err := service.Vote(carol.ID, first.ID, false); err == nil {
+		t.Error("Expected withdrawing a missing vote to fail")
+	}
+
+	t.Logf("✓ Reviews written, replaced and voted on")
+}
+
+func TestReviewService_ListForMangaPrivacy(t *testing.T) {
+	db := newTestDB(t)
+	seedManga(t, db, "manga-001", "Oda", 100, "Action")
+	userRepo := user.NewRepository(db)
+	mangaService := manga.NewService(manga.NewRepository(db), userRepo)
+	service := review.NewService(review.NewRepository(db), mangaService)
+
+	// alice is public, bob private, carol public with the entry hidden, dave followers-only
+	authors := map[string]models.ProfileVisibility{
+		"alice": models.ProfileVisibilityPublic,
+		"bob":   models.ProfileVisibilityPrivate,
+		"carol": models.ProfileVisibilityPublic,
+		"dave":  models.ProfileVisibilityFollowers,
+	}
+	ids := map[string]string{}
+	for name, visibility := range authors {
+		u := seedUser(t, db, name)
+		ids[name] = u.ID
+		if err := userRepo.UpdateVisibility(u.ID, visibility); err != nil {
+			t.Fatalf("Failed to set visibility: %v", err)
+		}
+		if err := mangaService.AddToLibrary(u.ID, models.LibraryAddRequest{MangaID: "manga-001", Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add to library: %v", err)
+		}
+		if _, _, err := service.Write(u.ID, "manga-001", models.ReviewRequest{Body: "Review by " + name}); err != nil {
+			t.Fatalf("Failed to write review: %v", err)
+		}
+	}
+	hidden := true
+	if _, err := mangaService.UpdateEntry(ids["carol"], "manga-001", models.LibraryEntryUpdateRequest{Hidden: &hidden}); err != nil {
+		t.Fatalf("Failed to hide entry: %v", err)
+	}
+	eve := seedUser(t, db, "eve")
+	if err := follow.NewRepository(db).Insert(eve.ID, ids["dave"]); err != nil {
+		t.Fatalf("Failed to follow: %v", err)
+	}
+
+	cases := map[string]struct {
+		viewerID string
+		expected []string
+	}{
+		"anonymous": {"", []string{"alice"}},
+		"follower":  {eve.ID, []string{"alice", "dave"}},
+		"private":   {ids["bob"], []string{"alice", "bob"}},
+		"hidden":    {ids["carol"], []string{"alice", "carol"}},
+	}
+	for name, tc := range cases {
+		reviews, total, err := service.ListForManga(tc.viewerID, "manga-001", models.ReviewQuery{})
+		if err != nil {
+			t.Fatalf("%s: failed to list reviews: %v", name, err)
+		}
+		seen := map[string]bool{}
+		for _, r := range reviews {
+			seen[r.Username] = true
+		}
+		if total != len(tc.expected) || len(reviews) != len(tc.expected) {
+			t.Errorf("%s: expected %d reviews, got %d (total %d)", name, len(tc.expected), len(reviews), total)
+		}
+		for _, author := range tc.expected {
+			if !seen[author] {
+				t.Errorf("%s: expected %s's review to be listed, got %+v", name, author, reviews)
+			}
+		}
+	}
+
+	t.Logf("✓ Reviews by private users and on hidden entries shown only to their authors")
+}
+
+func TestReviewQuery_Binding(t *testing.T) {
+	for _, rawQuery := range []string{"", "sort=newest", "sort=helpful&limit=10&offset=20"} {
+		var query models.ReviewQuery
+		if err := bindQuery("/manga/manga-001/reviews?"+rawQuery, &query); err != nil {
+			t.Errorf("Expected %q to bind, got %v", rawQuery, err)
+		}
+	}
+	for _, rawQuery := range []string{"sort=rating", "limit=500", "offset=-1"} {
+		var query models.ReviewQuery
+		if err := bindQuery("/manga/manga-001/reviews?"+rawQuery, &query); err == nil {
+			t.Errorf("Expected %q to be rejected", rawQuery)
+		}
+	}
+
+	t.Logf("✓ Review queries validated")
+}
@@ -0,0 +1,90 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tnphucccc/mangahub/internal/shelf"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := shelf.NormalizeTags([]string{"  Weekly Pulls ", "to reread", "weekly   pulls", "", "Recommended by Sam"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"weekly pulls", "to reread", "recommended by sam"}
+	if len(tags) != len(expected) {
+		t.Fatalf("Expected %d tags, got %d (%v)", len(expected), len(tags), tags)
+	}
+	for i, tag := range expected {
+		if tags[i] != tag {
+			t.Errorf("Expected tag %d to be '%s', got '%s'", i, tag, tags[i])
+		}
+	}
+
+	t.Logf("✓ Tags trimmed, lowercased and de-duplicated")
+}
+
+func TestNormalizeTags_Limits(t *testing.T) {
+	if _, err := shelf.NormalizeTags([]string{strings.Repeat("x", 33)}); err == nil {
+		t.Errorf("Expected error for tag longer than 32 characters")
+	}
+
+	many := make([]string, 21)
+	for i := range many {
+		many[i] = strings.Repeat("t", i+1)
+	}
+	if _, err := shelf.NormalizeTags(many); err == nil {
+		t.Errorf("Expected error for more than 20 tags")
+	}
+
+	tags, err := shelf.NormalizeTags(nil)
+	if err != nil || len(tags) != 0 {
+		t.Errorf("Expected empty tag list for nil input, got %v (%v)", tags, err)
+	}
+
+	t.Logf("✓ Tag limits enforced")
+}
+
+func TestShelfVisibility_Constants(t *testing.T) {
+	if models.ShelfVisibilityPrivate != "private" {
+		t.Errorf("Expected 'private', got '%s'", models.ShelfVisibilityPrivate)
+	}
+	if models.ShelfVisibilityPublic != "public" {
+		t.Errorf("Expected 'public', got '%s'", models.ShelfVisibilityPublic)
+	}
+
+	t.Logf("✓ Shelf visibility constants correct")
+}
+
+func TestShelfService_NamesUniqueIgnoringCase(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	service := shelf.NewService(shelf.NewRepository(db))
+
+	if _, err := service.Create(alice.ID, models.ShelfCreateRequest{Name: "Favorites"}); err != nil {
+		t.Fatalf("Failed to create shelf: %v", err)
+	}
+	if _, err := service.Create(alice.ID, models.ShelfCreateRequest{Name: "FAVORITES"}); err == nil || err.Error() != "shelf name already exists" {
+		t.Errorf("Expected 'shelf name already exists' for a name differing in case, got %v", err)
+	}
+
+	other, err := service.Create(alice.ID, models.ShelfCreateRequest{Name: "Weekly"})
+	if err != nil {
+		t.Fatalf("Failed to create shelf: %v", err)
+	}
+	rename := "favorites"
+	if _, err := service.Update(alice.ID, other.ID, models.ShelfUpdateRequest{Name: &rename}); err == nil || err.Error() != "shelf name already exists" {
+		t.Errorf("Expected 'shelf name already exists' when renaming onto a name differing in case, got %v", err)
+	}
+
+	// Another user's shelves do not clash
+	if _, err := service.Create(bob.ID, models.ShelfCreateRequest{Name: "favorites"}); err != nil {
+		t.Errorf("Expected another user to reuse the name, got %v", err)
+	}
+
+	t.Logf("✓ Shelf names unique per user regardless of case")
+}
@@ -0,0 +1,152 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/tnphucccc/mangahub/internal/auth"
+	grpchandler "github.com/tnphucccc/mangahub/internal/grpc"
+	"github.com/tnphucccc/mangahub/internal/grpc/pb"
+	"github.com/tnphucccc/mangahub/internal/manga"
+	"github.com/tnphucccc/mangahub/internal/profile"
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/internal/user"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func compositionEntry(status models.ReadingStatus, chapter float64, rating *int, author string, mangaStatus models.MangaStatus, genres ...string) models.UserProgressWithManga {
+	return models.UserProgressWithManga{
+		UserProgress: models.UserProgress{Status: status, CurrentChapter: models.ChapterNumber(chapter), Rating: rating},
+		Manga:        models.Manga{Author: author, Status: mangaStatus, Genres: genres},
+	}
+}
+
+func TestBuildComposition(t *testing.T) {
+	high, low := 9, 4
+	entries := []models.UserProgressWithManga{
+		compositionEntry(models.ReadingStatusCompleted, 100, &high, "Oda", models.MangaStatusOngoing, "Action", "Adventure", "action"),
+		compositionEntry(models.ReadingStatusDropped, 12.5, &low, "oda ", models.MangaStatusCompleted, "Action"),
+		compositionEntry(models.ReadingStatusReading, 40, nil, "", models.MangaStatusOngoing, "Romance"),
+		compositionEntry(models.ReadingStatusPlanToRead, 0, nil, "Togashi", models.MangaStatusHiatus, "action"),
+	}
+
+	composition := stats.BuildComposition(entries)
+	if composition.Titles != 4 {
+		t.Errorf("expected 4 titles, got %d", composition.Titles)
+	}
+
+	// Genres repeated on an entry count once, whatever their case
+	if len(composition.Genres) != 3 {
+		t.Fatalf("expected 3 genres, got %+v", composition.Genres)
+	}
+	action := composition.Genres[0]
+	if action.Name != "Action" || action.Titles != 3 || action.Started != 2 || action.ChaptersRead != 112 {
+		t.Errorf("unexpected Action group: %+v", action)
+	}
+	// Rates are over started titles, leaving out plan to read
+	if action.CompletionRate == nil || *action.CompletionRate != 0.5 || action.DroppedRate == nil || *action.DroppedRate != 0.5 {
+		t.Errorf("expected 50%% completed and dropped, got %v and %v", action.CompletionRate, action.DroppedRate)
+	}
+	if action.AverageRating == nil || *action.AverageRating != 6.5 {
+		t.Errorf("expected Action average 6.5, got %v", action.AverageRating)
+	}
+	if composition.Genres[1].Name != "Adventure" || composition.Genres[2].Name != "Romance" {
+		t.Errorf("expected ties sorted by name, got %+v", composition.Genres)
+	}
+	if composition.Genres[2].AverageRating != nil {
+		t.Errorf("expected no average for an unrated genre, got %v", *composition.Genres[2].AverageRating)
+	}
+
+	// Blank authors are left out
+	if len(composition.Authors) != 2 || composition.Authors[0].Name != "Oda" || composition.Authors[0].Titles != 2 {
+		t.Errorf("unexpected authors: %+v", composition.Authors)
+	}
+	togashi := composition.Authors[1]
+	if togashi.Started != 0 || togashi.CompletionRate != nil || togashi.DroppedRate != nil {
+		t.Errorf("expected no rates for an author with nothing started, got %+v", togashi)
+	}
+
+	if len(composition.PublicationStatuses) != 3 || composition.PublicationStatuses[0].Name != "ongoing" {
+		t.Errorf("unexpected publication statuses: %+v", composition.PublicationStatuses)
+	}
+
+	ratings := composition.Ratings
+	if ratings.Rated != 2 || ratings.Counts[8] != 1 || ratings.Counts[3] != 1 || ratings.AverageRating == nil || *ratings.AverageRating != 6.5 {
+		t.Errorf("unexpected rating histogram: %+v", ratings)
+	}
+}
+
+func TestGRPCGetLibraryStats_Privacy(t *testing.T) {
+	db := newTestDB(t)
+	alice := seedUser(t, db, "alice")
+	bob := seedUser(t, db, "bob")
+	seedManga(t, db, "manga-001", "Oda", 100, "Action", "Adventure")
+	seedManga(t, db, "manga-002", "Togashi", 50, "Action")
+	seedManga(t, db, "manga-003", "Araki", 80, "Horror")
+
+	jwtManager := auth.NewJWTManager("test-secret", 1)
+	userService := user.NewService(user.NewRepository(db), jwtManager)
+	mangaService := manga.NewService(manga.NewRepository(db), user.NewRepository(db))
+	server := grpchandler.NewServer(mangaService, stats.NewService(stats.NewRepository(db)), userService, profile.NewService(userService, mangaService))
+
+	for _, id := range []string{"manga-001", "manga-002", "manga-003"} {
+		if err := mangaService.AddToLibrary(alice.ID, models.LibraryAddRequest{MangaID: id, Status: models.ReadingStatusReading}); err != nil {
+			t.Fatalf("Failed to add to library: %v", err)
+		}
+	}
+	hidden := true
+	if _, err := mangaService.UpdateEntry(alice.ID, "manga-003", models.LibraryEntryUpdateRequest{Hidden: &hidden}); err != nil {
+		t.Fatalf("Failed to hide entry: %v", err)
+	}
+
+	as := func(u *models.User) context.Context {
+		token, err := jwtManager.GenerateToken(u)
+		if err != nil {
+			t.Fatalf("Failed to generate token: %v", err)
+		}
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+	request := &pb.LibraryStatsRequest{UserId: alice.ID}
+
+	own, err := server.GetLibraryStats(as(alice), request)
+	if err != nil {
+		t.Fatalf("GetLibraryStats failed for the owner: %v", err)
+	}
+	if own.Titles != 3 || len(own.Genres) != 3 || own.Genres[0].Name != "Action" || own.Genres[0].Titles != 2 {
+		t.Errorf("Expected the owner to see 3 titles led by Action, got %+v", own)
+	}
+
+	viewed, err := server.GetLibraryStats(as(bob), request)
+	if err != nil {
+		t.Fatalf("GetLibraryStats failed for a public library: %v", err)
+	}
+	if viewed.Titles != 2 || len(viewed.Authors) != 2 {
+		t.Errorf("Expected hidden entries to be left out for other users, got %+v", viewed)
+	}
+
+	if _, err := userService.UpdatePrivacy(alice.ID, models.PrivacyUpdateRequest{Visibility: models.ProfileVisibilityPrivate}); err != nil {
+		t.Fatalf("Failed to update privacy: %v", err)
+	}
+	for name, ctx := range map[string]context.Context{"another user": as(bob), "an anonymous caller": context.Background()} {
+		if _, err := server.GetLibraryStats(ctx, request); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for %s, got %v", name, err)
+		}
+	}
+	if _, err := server.GetLibraryStats(as(alice), request); err != nil {
+		t.Errorf("Expected the owner to see a private library, got %v", err)
+	}
+
+	if _, err := server.GetLibraryStats(context.Background(), &pb.LibraryStatsRequest{UserId: "user-nobody"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown user, got %v", err)
+	}
+	badToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer nope"))
+	if _, err := server.GetLibraryStats(badToken, request); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected Unauthenticated for a bad token, got %v", err)
+	}
+
+	t.Logf("✓ Library stats follow profile privacy over gRPC")
+}
@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tnphucccc/mangahub/internal/stats"
+	"github.com/tnphucccc/mangahub/pkg/models"
+)
+
+func TestComputeStreak(t *testing.T) {
+	now := time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		days    []string
+		current int
+		longest int
+	}{
+		{"no reading", []string{}, 0, 0},
+		{"read today", []string{"2026-10-01", "2026-10-02", "2026-10-03", "2026-10-17", "2026-10-18", "2026-10-19"}, 3, 3},
+		{"not yet today", []string{"2026-10-17", "2026-10-18"}, 2, 2},
+		{"broken streak", []string{"2026-10-01", "2026-10-02", "2026-10-03", "2026-10-04", "2026-10-17"}, 0, 4},
+		{"across months", []string{"2026-09-30", "2026-10-01"}, 0, 2},
+	}
+	for _, tt := range tests {
+		streak := stats.ComputeStreak(tt.days, now)
+		if streak.Current != tt.current || streak.Longest != tt.longest {
+			t.Errorf("%s: expected current %d and longest %d, got %d and %d", tt.name, tt.current, tt.longest, streak.Current, streak.Longest)
+		}
+		if len(tt.days) > 0 && (streak.LastReadOn == nil || *streak.LastReadOn != tt.days[len(tt.days)-1]) {
+			t.Errorf("%s: expected last read on %s, got %v", tt.name, tt.days[len(tt.days)-1], streak.LastReadOn)
+		}
+	}
+}
+
+func TestBucketChapters(t *testing.T) {
+	daily := map[string]int{"2026-10-05": 3, "2026-10-11": 4, "2026-10-12": 10, "2026-09-30": 99}
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	points, err := stats.BucketChapters(daily, models.TimelineBucketWeek, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Weeks start on Monday: Sep 28, Oct 5 and Oct 12
+	want := []models.TimelinePoint{{Start: "2026-09-28", Chapters: 99}, {Start: "2026-10-05", Chapters: 7}, {Start: "2026-10-12", Chapters: 10}}
+	if len(points) != len(want) {
+		t.Fatalf("expected %d weeks, got %+v", len(want), points)
+	}
+	for i := range want {
+		if points[i] != want[i] {
+			t.Errorf("week %d: expected %+v, got %+v", i, want[i], points[i])
+		}
+	}
+
+	days, err := stats.BucketChapters(daily, models.TimelineBucketDay, from, to)
+	if err != nil || len(days) != 14 || days[4].Chapters != 3 || days[0].Chapters != 0 {
+		t.Errorf("expected 14 zero-filled days, got %+v (%v)", days, err)
+	}
+
+	if _, err := stats.BucketChapters(daily, models.TimelineBucketDay, from.AddDate(-2, 0, 0), to); err == nil {
+		t.Error("expected an error for more than 366 days")
+	}
+}
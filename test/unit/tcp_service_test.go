@@ -1,6 +1,10 @@
 package unit
 
 import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"strconv"
 	"testing"
 	"time"
 
@@ -141,7 +145,7 @@ func TestTCPProgressMessage_Structure(t *testing.T) {
 	}
 
 	if progressMsg.CurrentChapter != 50 {
-		t.Errorf("Expected chapter 50, got %d", progressMsg.CurrentChapter)
+		t.Errorf("Expected chapter 50, got %s", progressMsg.CurrentChapter)
 	}
 
 	if progressMsg.Status != models.ReadingStatusReading {
@@ -174,7 +178,7 @@ func TestTCPProgressBroadcast_Structure(t *testing.T) {
 	}
 
 	if broadcast.CurrentChapter != 50 {
-		t.Errorf("Expected chapter 50, got %d", broadcast.CurrentChapter)
+		t.Errorf("Expected chapter 50, got %s", broadcast.CurrentChapter)
 	}
 
 	t.Logf("✓ TCP progress broadcast structure correct")
@@ -283,6 +287,190 @@ func TestTCPServer_BroadcastProgress(t *testing.T) {
 	t.Logf("✓ TCP broadcast queued successfully")
 }
 
+// connectTCPClient signs in to the TCP server as user and returns a reader for its messages
+func connectTCPClient(t *testing.T, addr string, jwtManager *auth.JWTManager, user *models.User) (net.Conn, *bufio.Reader) {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	token, err := jwtManager.GenerateToken(user)
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+	data, _ := json.Marshal(models.TCPMessage{
+		Type: models.TCPMessageTypeAuth,
+		Data: models.TCPAuthMessage{Token: token},
+	})
+	conn.Write(append(data, '\n'))
+
+	reader := bufio.NewReader(conn)
+	var reply models.TCPMessage
+	line, err := reader.ReadBytes('\n')
+	if err != nil || json.Unmarshal(line, &reply) != nil || reply.Type != models.TCPMessageTypeAuthSuccess {
+		t.Fatalf("Expected auth_success for %s, got %q (%v)", user.Username, line, err)
+	}
+	return conn, reader
+}
+
+// receivesBroadcast reports whether a broadcast arrives on the connection within a short wait
+func receivesBroadcast(conn net.Conn, reader *bufio.Reader) bool {
+	conn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return false
+	}
+	var msg models.TCPMessage
+	return json.Unmarshal(line, &msg) == nil && msg.Type == models.TCPMessageTypeBroadcast
+}
+
+func TestTCPServer_AudienceScopedBroadcast(t *testing.T) {
+	// Find a free port for the server
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	port := strconv.Itoa(probe.Addr().(*net.TCPAddr).Port)
+	probe.Close()
+
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server := tcp.NewServer(port, jwtManager)
+
+	audiences := map[string]models.BroadcastAudience{
+		"manga-public":    {Public: true},
+		"manga-followers": {UserIDs: []string{"owner", "follower"}},
+	}
+	server.UseAudienceResolver(func(broadcast models.TCPProgressBroadcast) (models.BroadcastAudience, error) {
+		return audiences[broadcast.MangaID], nil
+	})
+
+	if err := server.Start(); err != nil {
+		t.Fatalf("Failed to start server: %v", err)
+	}
+	defer server.Stop()
+
+	addr := net.JoinHostPort("127.0.0.1", port)
+	ownerConn, ownerReader := connectTCPClient(t, addr, jwtManager, &models.User{ID: "owner", Username: "owner"})
+	followerConn, followerReader := connectTCPClient(t, addr, jwtManager, &models.User{ID: "follower", Username: "follower"})
+	strangerConn, strangerReader := connectTCPClient(t, addr, jwtManager, &models.User{ID: "stranger", Username: "stranger"})
+
+	tests := []struct {
+		mangaID  string
+		owner    bool
+		follower bool
+		stranger bool
+	}{
+		{"manga-public", true, true, true},
+		{"manga-followers", true, true, false},
+		{"manga-unresolved", false, false, false}, // Empty audience
+	}
+
+	for _, tt := range tests {
+		server.BroadcastProgress(models.TCPProgressBroadcast{UserID: "owner", Username: "owner", MangaID: tt.mangaID, Timestamp: time.Now()})
+
+		if got := receivesBroadcast(ownerConn, ownerReader); got != tt.owner {
+			t.Errorf("%s: owner received = %v, want %v", tt.mangaID, got, tt.owner)
+		}
+		if got := receivesBroadcast(followerConn, followerReader); got != tt.follower {
+			t.Errorf("%s: follower received = %v, want %v", tt.mangaID, got, tt.follower)
+		}
+		if got := receivesBroadcast(strangerConn, strangerReader); got != tt.stranger {
+			t.Errorf("%s: stranger received = %v, want %v", tt.mangaID, got, tt.stranger)
+		}
+	}
+
+	t.Logf("✓ TCP broadcasts only reach their audience")
+}
+
+// readBroadcast returns the next broadcast on the connection, or nil if none arrives within a short wait
+func readBroadcast(conn net.Conn, reader *bufio.Reader) *models.TCPProgressBroadcast {
+	conn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return nil
+	}
+	var msg struct {
+		Type models.TCPMessageType       `json:"type"`
+		Data models.TCPProgressBroadcast `json:"data"`
+	}
+	if json.Unmarshal(line, &msg) != nil || msg.Type != models.TCPMessageTypeBroadcast {
+		return nil
+	}
+	return &msg.Data
+}
+
+func TestTCPServer_ProgressSenderIdentity(t *testing.T) {
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	port := strconv.Itoa(probe.Addr().(*net.TCPAddr).Port)
+	probe.Close()
+
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server := tcp.NewServer(port, jwtManager)
+	server.UseAudienceResolver(func(broadcast models.TCPProgressBroadcast) (models.BroadcastAudience, error) {
+		return models.BroadcastAudience{Public: true}, nil
+	})
+	if err := server.Start(); err != nil {
+		t.Fatalf("Failed to start server: %v", err)
+	}
+	defer server.Stop()
+
+	addr := net.JoinHostPort("127.0.0.1", port)
+	watcherConn, watcherReader := connectTCPClient(t, addr, jwtManager, &models.User{ID: "watcher", Username: "watcher"})
+	aliceConn, aliceReader := connectTCPClient(t, addr, jwtManager, &models.User{ID: "alice", Username: "alice"})
+
+	progress := func(userID, username string) []byte {
+		data, _ := json.Marshal(models.TCPMessage{
+			Type: models.TCPMessageTypeProgress,
+			Data: models.TCPProgressMessage{UserID: userID, Username: username, MangaID: "manga-001", CurrentChapter: 12},
+		})
+		return append(data, '\n')
+	}
+
+	// An unauthenticated connection cannot send progress at all
+	anonConn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer anonConn.Close()
+	anonConn.Write(progress("victim", "victim"))
+	var reply models.TCPMessage
+	line, err := bufio.NewReader(anonConn).ReadBytes('\n')
+	if err != nil || json.Unmarshal(line, &reply) != nil || reply.Type != models.TCPMessageTypeAuthFailed {
+		t.Errorf("Expected auth_failed for unauthenticated progress, got %q (%v)", line, err)
+	}
+	if got := readBroadcast(watcherConn, watcherReader); got != nil {
+		t.Errorf("Expected no broadcast for unauthenticated progress, got %+v", got)
+	}
+
+	// A signed-in user always broadcasts as themselves
+	aliceConn.Write(progress("victim", "victim"))
+	if got := readBroadcast(watcherConn, watcherReader); got == nil || got.UserID != "alice" || got.Username != "alice" {
+		t.Errorf("Expected the broadcast to come from alice, got %+v", got)
+	}
+	readBroadcast(aliceConn, aliceReader)
+
+	// Service bridges act on behalf of the user they name
+	if err := tcp.NotifyProgress(addr, jwtManager, models.TCPProgressBroadcast{UserID: "bob", Username: "bob", MangaID: "manga-001", CurrentChapter: 3}); err != nil {
+		t.Fatalf("NotifyProgress failed: %v", err)
+	}
+	if got := readBroadcast(watcherConn, watcherReader); got == nil || got.UserID != "bob" || got.CurrentChapter != 3 {
+		t.Errorf("Expected the bridged broadcast for bob, got %+v", got)
+	}
+
+	// A bridge token signed with another secret is refused
+	if err := tcp.NotifyProgress(addr, auth.NewJWTManager("other-secret", 7), models.TCPProgressBroadcast{UserID: "bob", MangaID: "manga-001"}); err == nil {
+		t.Error("Expected a service token signed with another secret to be rejected")
+	}
+
+	t.Logf("✓ TCP progress is only accepted from authenticated senders")
+}
+
 // ==========================================
 // TCP Protocol Flow Tests
 // ==========================================
@@ -1,9 +1,13 @@
 package unit
 
 import (
+	"encoding/json"
+	"net"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/tnphucccc/mangahub/internal/auth"
 	"github.com/tnphucccc/mangahub/internal/udp"
 	"github.com/tnphucccc/mangahub/pkg/models"
 )
@@ -174,7 +178,7 @@ func TestUDPNotification_Structure(t *testing.T) {
 	}
 
 	if notification.ChapterNumber != 1100 {
-		t.Errorf("Expected chapter 1100, got %d", notification.ChapterNumber)
+		t.Errorf("Expected chapter 1100, got %s", notification.ChapterNumber)
 	}
 
 	if notification.ChapterTitle != "The Final Battle" {
@@ -210,7 +214,8 @@ func TestUDPErrorMessage_Structure(t *testing.T) {
 // ==========================================
 
 func TestUDPServer_NewServer(t *testing.T) {
-	server := udp.NewServer("9091")
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server := udp.NewServer("9091", jwtManager)
 
 	if server == nil {
 		t.Fatal("Expected server to be created, got nil")
@@ -224,7 +229,8 @@ func TestUDPServer_NewServer(t *testing.T) {
 }
 
 func TestUDPServer_GetStats(t *testing.T) {
-	server := udp.NewServer("9091")
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server := udp.NewServer("9091", jwtManager)
 
 	stats := server.GetStats()
 
@@ -263,7 +269,8 @@ func TestUDPServer_GetStats(t *testing.T) {
 }
 
 func TestUDPServer_BroadcastNotification(t *testing.T) {
-	server := udp.NewServer("9091")
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server := udp.NewServer("9091", jwtManager)
 
 	notification := models.UDPNotification{
 		MangaID:       "manga-123",
@@ -437,3 +444,155 @@ func TestUDPProtocolFlow_RegistrationFailed(t *testing.T) {
 
 	t.Logf("✓ UDP registration failed flow validated")
 }
+
+func TestUDPNotify(t *testing.T) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer conn.Close()
+
+	notification := models.UDPNotification{UserID: "user-123", Message: "Goal reached: read 10 chapters this week"}
+	if err := udp.Notify(conn.LocalAddr().String(), notification); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+
+	buf := make([]byte, 4096)
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _, err := conn.ReadFromUDP(buf)
+	if err != nil {
+		t.Fatalf("Failed to read notification: %v", err)
+	}
+
+	var msg struct {
+		Type models.UDPMessageType  `json:"type"`
+		Data models.UDPNotification `json:"data"`
+	}
+	if err := json.Unmarshal(buf[:n], &msg); err != nil {
+		t.Fatalf("Failed to decode notification: %v", err)
+	}
+	if msg.Type != models.UDPMessageTypeNotification || msg.Data.UserID != "user-123" || msg.Data.Message != notification.Message {
+		t.Errorf("Expected the notification for user-123, got %+v", msg)
+	}
+
+	t.Logf("✓ Notifications handed to the UDP server")
+}
+
+// registerUDPClient registers a client with the server at addr and returns the
+// type of the reply
+func registerUDPClient(t *testing.T, addr string, register models.UDPRegisterMessage) (*net.UDPConn, models.UDPMessageType) {
+	t.Helper()
+
+	serverAddr, _ := net.ResolveUDPAddr("udp", addr)
+	conn, err := net.DialUDP("udp", nil, serverAddr)
+	if err != nil {
+		t.Fatalf("Failed to dial UDP server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	data, _ := json.Marshal(models.UDPMessage{Type: models.UDPMessageTypeRegister, Timestamp: time.Now(), Data: register})
+	if _, err := conn.Write(data); err != nil {
+		t.Fatalf("Failed to register: %v", err)
+	}
+
+	var reply models.UDPMessage
+	buf := make([]byte, 2048)
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, err := conn.Read(buf)
+	if err != nil || json.Unmarshal(buf[:n], &reply) != nil {
+		t.Fatalf("Expected a registration reply, got %v", err)
+	}
+	return conn, reply.Type
+}
+
+// receivesNotification reports whether a notification arrives on conn within a short wait
+func receivesNotification(conn *net.UDPConn) bool {
+	buf := make([]byte, 2048)
+	conn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+	n, err := conn.Read(buf)
+	if err != nil {
+		return false
+	}
+	var msg models.UDPMessage
+	return json.Unmarshal(buf[:n], &msg) == nil && msg.Type == models.UDPMessageTypeNotification
+}
+
+// startUDPServer starts a UDP server on a free port for the duration of the test
+// and returns it with its address
+func startUDPServer(t *testing.T, jwtManager *auth.JWTManager) (*udp.Server, string) {
+	t.Helper()
+
+	probe, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	port := strconv.Itoa(probe.LocalAddr().(*net.UDPAddr).Port)
+	probe.Close()
+
+	server := udp.NewServer(port, jwtManager)
+	if err := server.Start(); err != nil {
+		t.Fatalf("Failed to start server: %v", err)
+	}
+	t.Cleanup(func() { server.Stop() })
+
+	return server, net.JoinHostPort("127.0.0.1", port)
+}
+
+func TestUDPServer_PersonalNotificationsNeedToken(t *testing.T) {
+	jwtManager := auth.NewJWTManager("test-secret", 7)
+	server, addr := startUDPServer(t, jwtManager)
+
+	aliceToken, _ := jwtManager.GenerateToken(&models.User{ID: "alice", Username: "alice"})
+	bobToken, _ := jwtManager.GenerateToken(&models.User{ID: "bob", Username: "bob"})
+	serviceToken, _ := jwtManager.GenerateServiceToken("api-server")
+
+	alice, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "alice-cli", Token: aliceToken})
+	if reply != models.UDPMessageTypeRegisterSuccess {
+		t.Fatalf("Expected alice to register, got %s", reply)
+	}
+	// Registrations claiming alice's user ID without her token
+	anonymous, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "anonymous", UserID: "alice"})
+	if reply != models.UDPMessageTypeRegisterFailed {
+		t.Errorf("Expected a registration without a token to fail, got %s", reply)
+	}
+	forged, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "forged", Token: "not-a-jwt", UserID: "alice"})
+	if reply != models.UDPMessageTypeRegisterFailed {
+		t.Errorf("Expected a registration with an invalid token to fail, got %s", reply)
+	}
+	mismatched, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "bob-cli", Token: bobToken, UserID: "alice"})
+	if reply != models.UDPMessageTypeRegisterSuccess {
+		t.Fatalf("Expected bob to register, got %s", reply)
+	}
+	service, reply := registerUDPClient(t, addr, models.UDPRegisterMessage{ClientID: "api-server", Token: serviceToken})
+	if reply != models.UDPMessageTypeRegisterSuccess {
+		t.Fatalf("Expected the service to register, got %s", reply)
+	}
+
+	tests := []struct {
+		name         string
+		notification models.UDPNotification
+		receivers    map[*net.UDPConn]bool
+	}{
+		{
+			name:         "goal reached",
+			notification: models.UDPNotification{UserID: "alice", Message: "Goal reached: 10 chapters this week"},
+			receivers:    map[*net.UDPConn]bool{alice: true, anonymous: false, forged: false, mismatched: false, service: false},
+		},
+		{
+			name:         "chapter release",
+			notification: models.UDPNotification{MangaID: "one-piece", MangaTitle: "One Piece", Message: "New chapter"},
+			receivers:    map[*net.UDPConn]bool{alice: true, anonymous: false, forged: false, mismatched: true, service: true},
+		},
+	}
+
+	for _, tt := range tests {
+		server.BroadcastNotification(tt.notification)
+		for conn, want := range tt.receivers {
+			if got := receivesNotification(conn); got != want {
+				t.Errorf("%s: client %s received = %v, want %v", tt.name, conn.LocalAddr(), got, want)
+			}
+		}
+	}
+
+	t.Logf("✓ Personal notifications reach only clients registered with the user's token")
+}